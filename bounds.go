@@ -0,0 +1,138 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"fmt"
+	"time"
+)
+
+// Bounds describes whether the start and end times of a Period are themselves part of the period.
+type Bounds uint8
+
+const (
+	// ClosedOpen includes the start time but not the end time, eg [start, end). This is the zero value of Bounds
+	// and matches the historical behavior of Period.
+	ClosedOpen Bounds = iota
+	// ClosedClosed includes both the start and end times, eg [start, end]
+	ClosedClosed
+	// OpenOpen includes neither the start nor end time, eg (start, end)
+	OpenOpen
+	// OpenClosed includes the end time but not the start time, eg (start, end]
+	OpenClosed
+)
+
+// boundsOf returns the Bounds with the given inclusivity on each end.
+func boundsOf(startInclusive, endInclusive bool) Bounds {
+	switch {
+	case startInclusive && endInclusive:
+		return ClosedClosed
+	case startInclusive:
+		return ClosedOpen
+	case endInclusive:
+		return OpenClosed
+	}
+	return OpenOpen
+}
+
+// StartInclusive returns whether the start time is part of a period with these bounds.
+func (b Bounds) StartInclusive() bool {
+	return b == ClosedOpen || b == ClosedClosed
+}
+
+// EndInclusive returns whether the end time is part of a period with these bounds.
+func (b Bounds) EndInclusive() bool {
+	return b == ClosedClosed || b == OpenClosed
+}
+
+// String returns the interval notation for the bounds, eg "[)" for ClosedOpen.
+func (b Bounds) String() string {
+	switch b {
+	case ClosedOpen:
+		return "[)"
+	case ClosedClosed:
+		return "[]"
+	case OpenOpen:
+		return "()"
+	case OpenClosed:
+		return "(]"
+	}
+	return fmt.Sprintf("Bounds(%d)", uint8(b))
+}
+
+// MarshalText implements encoding.TextMarshaler using the interval notation returned by String.
+func (b Bounds) MarshalText() ([]byte, error) {
+	if b > OpenClosed {
+		return nil, fmt.Errorf("invalid bounds %d", uint8(b))
+	}
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the interval notation returned by String.
+func (b *Bounds) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "[)":
+		*b = ClosedOpen
+	case "[]":
+		*b = ClosedClosed
+	case "()":
+		*b = OpenOpen
+	case "(]":
+		*b = OpenClosed
+	default:
+		return fmt.Errorf("invalid bounds %q", text)
+	}
+	return nil
+}
+
+// compareStarts compares where two periods begin, returning -1 if a begins before b, 1 if a begins after b and 0 if
+// they begin at the same point. A zero start time is unbounded and an inclusive start begins before an exclusive
+// start at the same time.
+func compareStarts(a, b Period) int {
+	return compareIntervalStarts(a.Interval(), b.Interval(), time.Time.Compare)
+}
+
+// compareEnds compares where two periods end, returning -1 if a ends before b, 1 if a ends after b and 0 if they
+// end at the same point. A zero end time is unbounded and an inclusive end ends after an exclusive end at the same
+// time.
+func compareEnds(a, b Period) int {
+	return compareIntervalEnds(a.Interval(), b.Interval(), time.Time.Compare)
+}
+
+// compareInclusive orders two inclusivity flags with exclusive before inclusive.
+func compareInclusive(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
+
+// startsBeforeEnd returns whether the start of period s comes before the end of period e such that at least one
+// instant is after the start of s and before the end of e.
+func startsBeforeEnd(s, e Period) bool {
+	return intervalStartsBeforeEnd(s.Interval(), e.Interval(), time.Time.Compare)
+}
+
+// connects returns whether period b, which must not begin before period a, either intersects a or begins exactly
+// where a ends without leaving any time uncovered between them.
+func connects(a, b Period) bool {
+	if startsBeforeEnd(b, a) {
+		return true
+	}
+	return b.Start.Equal(a.End) && (a.Bounds.EndInclusive() || b.Bounds.StartInclusive())
+}
@@ -0,0 +1,144 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBounds_Inclusive(t *testing.T) {
+	tests := []struct {
+		name           string
+		b              Bounds
+		startInclusive bool
+		endInclusive   bool
+	}{
+		{name: "closed-open includes only the start", b: ClosedOpen, startInclusive: true},
+		{name: "closed-closed includes both ends", b: ClosedClosed, startInclusive: true, endInclusive: true},
+		{name: "open-open includes neither end", b: OpenOpen},
+		{name: "open-closed includes only the end", b: OpenClosed, endInclusive: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.startInclusive, test.b.StartInclusive())
+			assert.Equal(t, test.endInclusive, test.b.EndInclusive())
+			assert.Equal(t, test.b, boundsOf(test.startInclusive, test.endInclusive))
+		})
+	}
+}
+
+func TestBounds_String(t *testing.T) {
+	assert.Equal(t, "[)", ClosedOpen.String())
+	assert.Equal(t, "[]", ClosedClosed.String())
+	assert.Equal(t, "()", OpenOpen.String())
+	assert.Equal(t, "(]", OpenClosed.String())
+	assert.Equal(t, "Bounds(9)", Bounds(9).String())
+}
+
+func TestBounds_MarshalText(t *testing.T) {
+	for _, b := range []Bounds{ClosedOpen, ClosedClosed, OpenOpen, OpenClosed} {
+		text, err := b.MarshalText()
+		require.NoError(t, err)
+		var result Bounds
+		require.NoError(t, result.UnmarshalText(text))
+		assert.Equal(t, b, result)
+	}
+	_, err := Bounds(9).MarshalText()
+	assert.Error(t, err)
+	var b Bounds
+	assert.Error(t, b.UnmarshalText([]byte("[[")))
+}
+
+func TestPeriod_boundsJSON(t *testing.T) {
+	out, err := json.Marshal(NewBoundedPeriod(time.Unix(10, 0).UTC(), time.Unix(20, 0).UTC(), ClosedClosed))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"start":"1970-01-01T00:00:10Z","end":"1970-01-01T00:00:20Z","bounds":"[]"}`, string(out))
+	out, err = json.Marshal(NewPeriod(time.Unix(10, 0).UTC(), time.Unix(20, 0).UTC()))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"start":"1970-01-01T00:00:10Z","end":"1970-01-01T00:00:20Z"}`, string(out))
+}
+
+func TestCompareStarts(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Period
+		expected int
+	}{
+		{
+			name:     "earlier start comes first",
+			a:        NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			b:        NewPeriod(time.Unix(20, 0), time.Unix(50, 0)),
+			expected: -1,
+		}, {
+			name:     "unbounded start comes first",
+			a:        NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			b:        NewPeriod(time.Time{}, time.Unix(50, 0)),
+			expected: 1,
+		}, {
+			name: "unbounded starts are equal",
+			a:    NewBoundedPeriod(time.Time{}, time.Unix(50, 0), OpenOpen),
+			b:    NewPeriod(time.Time{}, time.Unix(50, 0)),
+		}, {
+			name:     "inclusive start comes before exclusive start at the same time",
+			a:        NewBoundedPeriod(time.Unix(10, 0), time.Unix(50, 0), ClosedOpen),
+			b:        NewBoundedPeriod(time.Unix(10, 0), time.Unix(50, 0), OpenOpen),
+			expected: -1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, compareStarts(test.a, test.b))
+		})
+	}
+}
+
+func TestCompareEnds(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Period
+		expected int
+	}{
+		{
+			name:     "earlier end comes first",
+			a:        NewPeriod(time.Unix(10, 0), time.Unix(40, 0)),
+			b:        NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			expected: -1,
+		}, {
+			name:     "unbounded end comes last",
+			a:        NewPeriod(time.Unix(10, 0), time.Time{}),
+			b:        NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			expected: 1,
+		}, {
+			name: "unbounded ends are equal",
+			a:    NewPeriod(time.Unix(10, 0), time.Time{}),
+			b:    NewBoundedPeriod(time.Unix(20, 0), time.Time{}, ClosedClosed),
+		}, {
+			name:     "inclusive end comes after exclusive end at the same time",
+			a:        NewBoundedPeriod(time.Unix(10, 0), time.Unix(50, 0), ClosedClosed),
+			b:        NewBoundedPeriod(time.Unix(10, 0), time.Unix(50, 0), ClosedOpen),
+			expected: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, compareEnds(test.a, test.b))
+		})
+	}
+}
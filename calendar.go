@@ -0,0 +1,239 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"fmt"
+	"time"
+)
+
+// CalendarUnit is a unit of the local civil calendar, such as a day or a month. Every calendar unit begins at
+// local midnight.
+type CalendarUnit int
+
+const (
+	// UnitDay is a single calendar day
+	UnitDay CalendarUnit = iota
+	// UnitWeek is a calendar week of seven days
+	UnitWeek
+	// UnitMonth is a calendar month
+	UnitMonth
+	// UnitQuarter is a calendar quarter of three months, beginning in January, April, July or October
+	UnitQuarter
+	// UnitYear is a calendar year
+	UnitYear
+)
+
+// String returns the name of the calendar unit.
+func (u CalendarUnit) String() string {
+	switch u {
+	case UnitDay:
+		return "day"
+	case UnitWeek:
+		return "week"
+	case UnitMonth:
+		return "month"
+	case UnitQuarter:
+		return "quarter"
+	case UnitYear:
+		return "year"
+	}
+	return fmt.Sprintf("CalendarUnit(%d)", int(u))
+}
+
+// DayOf returns the local calendar day containing t in the given location. Days with a daylight saving time
+// transition are 23 or 25 hours long. If loc is nil, UTC is used.
+func DayOf(t time.Time, loc *time.Location) Period {
+	return calendarPeriodOf(t, UnitDay, time.Sunday, loc)
+}
+
+// WeekOf returns the local calendar week containing t in the given location, where weeks begin on the given weekday.
+// If loc is nil, UTC is used.
+func WeekOf(t time.Time, weekStart time.Weekday, loc *time.Location) Period {
+	return calendarPeriodOf(t, UnitWeek, weekStart, loc)
+}
+
+// MonthOf returns the local calendar month containing t in the given location. If loc is nil, UTC is used.
+func MonthOf(t time.Time, loc *time.Location) Period {
+	return calendarPeriodOf(t, UnitMonth, time.Sunday, loc)
+}
+
+// QuarterOf returns the local calendar quarter containing t in the given location. If loc is nil, UTC is used.
+func QuarterOf(t time.Time, loc *time.Location) Period {
+	return calendarPeriodOf(t, UnitQuarter, time.Sunday, loc)
+}
+
+// YearOf returns the local calendar year containing t in the given location. If loc is nil, UTC is used.
+func YearOf(t time.Time, loc *time.Location) Period {
+	return calendarPeriodOf(t, UnitYear, time.Sunday, loc)
+}
+
+// ISOWeek returns the given ISO 8601 week of the given year in the given location. ISO weeks begin on Monday, and
+// week 1 is the week containing the 4th of January, so the first and last weeks of an ISO year may include days of
+// the adjacent calendar years. An error is returned if the week is not between 1 and the number of weeks in the year,
+// which is either 52 or 53. If loc is nil, UTC is used.
+func ISOWeek(year, week int, loc *time.Location) (Period, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	// the 28th of December is always in the last ISO week of the year
+	_, weeks := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	if week < 1 || week > weeks {
+		return Period{}, fmt.Errorf("ISO year %d does not have a week %d", year, week)
+	}
+	firstWeek := startOfUnit(startOfDay(year, time.January, 4, loc), UnitWeek, time.Monday, loc)
+	start := addUnits(firstWeek, UnitWeek, week-1)
+	return NewPeriod(start, addUnits(start, UnitWeek, 1)), nil
+}
+
+// NextCalendar returns the calendar unit in the given location that follows the unit beginning at the start of p,
+// where p is usually a period returned by DayOf, WeekOf, MonthOf, QuarterOf, YearOf or ISOWeek. Weeks begin on the
+// same weekday as p. If loc is nil, UTC is used.
+func NextCalendar(p Period, unit CalendarUnit, loc *time.Location) Period {
+	if loc == nil {
+		loc = time.UTC
+	}
+	start := addUnits(startOfUnit(p.Start, unit, p.Start.In(loc).Weekday(), loc), unit, 1)
+	return NewPeriod(start, addUnits(start, unit, 1))
+}
+
+// PrevCalendar returns the calendar unit in the given location that precedes the unit beginning at the start of p,
+// where p is usually a period returned by DayOf, WeekOf, MonthOf, QuarterOf, YearOf or ISOWeek. Weeks begin on the
+// same weekday as p. If loc is nil, UTC is used.
+func PrevCalendar(p Period, unit CalendarUnit, loc *time.Location) Period {
+	if loc == nil {
+		loc = time.UTC
+	}
+	end := startOfUnit(p.Start, unit, p.Start.In(loc).Weekday(), loc)
+	return NewPeriod(addUnits(end, unit, -1), end)
+}
+
+// calendarPeriodOf returns the calendar unit containing t in the given location.
+func calendarPeriodOf(t time.Time, unit CalendarUnit, weekStart time.Weekday, loc *time.Location) Period {
+	if loc == nil {
+		loc = time.UTC
+	}
+	start := startOfUnit(t, unit, weekStart, loc)
+	return NewPeriod(start, addUnits(start, unit, 1))
+}
+
+// SplitBy divides the Period into consecutive segments along the boundaries of the given calendar unit in the given
+// location, eg at every local midnight for UnitDay. Weeks begin on Sunday; use SplitByWeek for weeks that begin on
+// a different day. Because boundaries are calculated on the local calendar rather than by adding fixed durations,
+// days with a daylight saving time transition produce a single 23 or 25 hour segment.
+//
+// The first and last segments are truncated to the start and end of the Period and keep its bounds; every other
+// boundary is included in the segment that begins there. A Period that is unbounded on either end cannot be split
+// and is returned as the only segment.
+func (p Period) SplitBy(unit CalendarUnit, loc *time.Location) []Period {
+	return p.splitBy(unit, time.Sunday, loc)
+}
+
+// SplitByWeek divides the Period into consecutive segments at the start of every week in the given location, where
+// weeks begin on the given weekday. It otherwise behaves like SplitBy.
+func (p Period) SplitByWeek(weekStart time.Weekday, loc *time.Location) []Period {
+	return p.splitBy(UnitWeek, weekStart, loc)
+}
+
+// splitBy is the internal implementation of SplitBy and SplitByWeek.
+func (p Period) splitBy(unit CalendarUnit, weekStart time.Weekday, loc *time.Location) []Period {
+	if p.empty() {
+		return []Period{}
+	}
+	if p.Start.IsZero() || p.End.IsZero() {
+		return []Period{p}
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	segments := make([]Period, 0)
+	segment := p
+	boundary := startOfUnit(p.Start, unit, weekStart, loc)
+	for {
+		boundary = addUnits(boundary, unit, 1)
+		if !boundary.Before(p.End) {
+			return append(segments, segment)
+		}
+		segments = append(segments, NewBoundedPeriod(segment.Start, boundary, boundsOf(segment.Bounds.StartInclusive(), false)))
+		segment = NewBoundedPeriod(boundary, p.End, boundsOf(true, p.Bounds.EndInclusive()))
+	}
+}
+
+// startOfUnit returns the first instant of the calendar unit containing t in the given location.
+func startOfUnit(t time.Time, unit CalendarUnit, weekStart time.Weekday, loc *time.Location) time.Time {
+	local := t.In(loc)
+	year, month, day := local.Date()
+	switch unit {
+	case UnitWeek:
+		day -= (int(local.Weekday()) - int(weekStart) + DaysInWeek) % DaysInWeek
+	case UnitMonth:
+		day = 1
+	case UnitQuarter:
+		month -= (month - 1) % 3
+		day = 1
+	case UnitYear:
+		month, day = time.January, 1
+	}
+	return startOfDay(year, month, day, loc)
+}
+
+// addUnits returns the first instant of the calendar unit n units after the unit beginning at start. start must be
+// the first instant of a calendar unit.
+func addUnits(start time.Time, unit CalendarUnit, n int) time.Time {
+	year, month, day := start.Date()
+	switch unit {
+	case UnitDay:
+		day += n
+	case UnitWeek:
+		day += n * DaysInWeek
+	case UnitMonth:
+		month += time.Month(n)
+	case UnitQuarter:
+		month += time.Month(3 * n)
+	case UnitYear:
+		year += n
+	}
+	return startOfDay(year, month, day, start.Location())
+}
+
+// startOfDay returns the first instant of the given local date. This is usually midnight, but in locations where
+// a daylight saving time transition skips over midnight, the day begins at the end of the transition instead.
+func startOfDay(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return fromWallClock(time.Date(year, month, day, 0, 0, 0, 0, time.UTC), loc)
+}
+
+// wallClock returns the local wall clock time of t in the given location as a time in UTC, so that wall clock times
+// can be compared and added to without regard for changes in the location's offset.
+func wallClock(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(
+		local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), local.Second(), local.Nanosecond(),
+		time.UTC)
+}
+
+// fromWallClock is the inverse of wallClock, returning the time at which the clock in the given location shows
+// the wall clock time stored in wall. A wall clock time that is repeated by a daylight saving time transition
+// resolves to its first occurrence, and a wall clock time that is skipped by a transition resolves to the end of
+// the transition.
+func fromWallClock(wall time.Time, loc *time.Location) time.Time {
+	t := time.Date(
+		wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), loc)
+	if !wallClock(t, loc).Equal(wall) {
+		// the wall clock time does not exist, so time.Date returned a time before the transition
+		_, end := t.ZoneBounds()
+		return end
+	}
+	return t
+}
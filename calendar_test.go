@@ -0,0 +1,318 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriod_SplitBy(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	tests := []struct {
+		loc      *time.Location
+		name     string
+		p        Period
+		expected []Period
+		unit     CalendarUnit
+	}{
+		{
+			name: "period within a single day returns the period",
+			p:    NewPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 21, 0, 0, 0, time.UTC)),
+			unit: UnitDay,
+			loc:  time.UTC,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 21, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name: "period spanning days is split at midnight",
+			p:    NewPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2019, 1, 3, 2, 0, 0, 0, time.UTC)),
+			unit: UnitDay,
+			loc:  time.UTC,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 3, 2, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name: "period ending at midnight is not split at its end",
+			p:    NewPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)),
+			unit: UnitDay,
+			loc:  time.UTC,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name: "23 hour local day is a single segment",
+			p:    NewPeriod(time.Date(2019, 3, 9, 12, 0, 0, 0, chiTz), time.Date(2019, 3, 11, 12, 0, 0, 0, chiTz)),
+			unit: UnitDay,
+			loc:  chiTz,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 3, 9, 12, 0, 0, 0, chiTz), time.Date(2019, 3, 10, 0, 0, 0, 0, chiTz)),
+				NewPeriod(time.Date(2019, 3, 10, 0, 0, 0, 0, chiTz), time.Date(2019, 3, 11, 0, 0, 0, 0, chiTz)),
+				NewPeriod(time.Date(2019, 3, 11, 0, 0, 0, 0, chiTz), time.Date(2019, 3, 11, 12, 0, 0, 0, chiTz)),
+			},
+		}, {
+			name: "25 hour local day is a single segment",
+			p:    NewPeriod(time.Date(2019, 11, 3, 0, 0, 0, 0, chiTz), time.Date(2019, 11, 4, 12, 0, 0, 0, chiTz)),
+			unit: UnitDay,
+			loc:  chiTz,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 11, 3, 0, 0, 0, 0, chiTz), time.Date(2019, 11, 4, 0, 0, 0, 0, chiTz)),
+				NewPeriod(time.Date(2019, 11, 4, 0, 0, 0, 0, chiTz), time.Date(2019, 11, 4, 12, 0, 0, 0, chiTz)),
+			},
+		}, {
+			name: "UTC period is split at local midnight",
+			p:    NewPeriod(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)),
+			unit: UnitDay,
+			loc:  chiTz,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 0, 0, 0, 0, chiTz)),
+				NewPeriod(time.Date(2019, 1, 1, 0, 0, 0, 0, chiTz), time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name: "period is split at the start of each week on Sunday",
+			p:    NewPeriod(time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 15, 0, 0, 0, 0, time.UTC)),
+			unit: UnitWeek,
+			loc:  time.UTC,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 6, 0, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 13, 0, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 13, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 15, 0, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name: "period is split at the start of each month",
+			p:    NewPeriod(time.Date(2019, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2019, 3, 15, 0, 0, 0, 0, time.UTC)),
+			unit: UnitMonth,
+			loc:  time.UTC,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 3, 15, 0, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name: "period is split at the start of each quarter",
+			p:    NewPeriod(time.Date(2019, 2, 15, 0, 0, 0, 0, time.UTC), time.Date(2019, 8, 15, 0, 0, 0, 0, time.UTC)),
+			unit: UnitQuarter,
+			loc:  time.UTC,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 2, 15, 0, 0, 0, 0, time.UTC), time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 8, 15, 0, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name: "period is split at the start of each year",
+			p:    NewPeriod(time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)),
+			unit: UnitYear,
+			loc:  time.UTC,
+			expected: []Period{
+				NewPeriod(time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name: "bounds of the period are kept on the first and last segments",
+			p:    NewBoundedPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2019, 1, 2, 5, 0, 0, 0, time.UTC), OpenClosed),
+			unit: UnitDay,
+			loc:  time.UTC,
+			expected: []Period{
+				NewBoundedPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), OpenOpen),
+				NewBoundedPeriod(time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 2, 5, 0, 0, 0, time.UTC), ClosedClosed),
+			},
+		}, {
+			name:     "unbounded period is returned unsplit",
+			p:        NewPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Time{}),
+			unit:     UnitDay,
+			loc:      time.UTC,
+			expected: []Period{NewPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Time{})},
+		}, {
+			name:     "empty period returns no segments",
+			p:        NewPeriod(time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 5, 0, 0, 0, time.UTC)),
+			unit:     UnitDay,
+			loc:      time.UTC,
+			expected: []Period{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.p.SplitBy(test.unit, test.loc)
+			require.Len(t, result, len(test.expected))
+			for i := range result {
+				assert.True(t, test.expected[i].Equals(result[i]), "expected %v, got %v", test.expected[i], result[i])
+			}
+		})
+	}
+}
+
+func TestPeriod_SplitByWeek(t *testing.T) {
+	p := NewPeriod(time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 15, 0, 0, 0, 0, time.UTC))
+	assert.Equal(
+		t,
+		[]Period{
+			NewPeriod(time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 7, 0, 0, 0, 0, time.UTC)),
+			NewPeriod(time.Date(2019, 1, 7, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 14, 0, 0, 0, 0, time.UTC)),
+			NewPeriod(time.Date(2019, 1, 14, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 15, 0, 0, 0, 0, time.UTC)),
+		},
+		p.SplitByWeek(time.Monday, time.UTC),
+	)
+}
+
+func TestStartOfDay(t *testing.T) {
+	spTz, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2019, 3, 10, 0, 0, 0, 0, chiTz), startOfDay(2019, 3, 10, chiTz))
+	// Sao Paulo skipped from 00:00 to 01:00 on 2018-11-04
+	assert.True(t, time.Date(2018, 11, 4, 1, 0, 0, 0, spTz).Equal(startOfDay(2018, 11, 4, spTz)))
+}
+
+func TestCalendarUnit_String(t *testing.T) {
+	assert.Equal(t, "quarter", UnitQuarter.String())
+	assert.Equal(t, "CalendarUnit(9)", CalendarUnit(9).String())
+}
+
+func TestCalendarConstructors(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	tm := time.Date(2019, 3, 10, 12, 0, 0, 0, chiTz)
+	tests := []struct {
+		name     string
+		result   Period
+		expected Period
+	}{
+		{
+			name:     "day with a DST transition is 23 hours",
+			result:   DayOf(tm, chiTz),
+			expected: NewPeriod(time.Date(2019, 3, 10, 0, 0, 0, 0, chiTz), time.Date(2019, 3, 11, 0, 0, 0, 0, chiTz)),
+		}, {
+			name:     "day in a different location",
+			result:   DayOf(time.Date(2019, 3, 10, 3, 0, 0, 0, time.UTC), chiTz),
+			expected: NewPeriod(time.Date(2019, 3, 9, 0, 0, 0, 0, chiTz), time.Date(2019, 3, 10, 0, 0, 0, 0, chiTz)),
+		}, {
+			name:     "nil location is UTC",
+			result:   DayOf(tm, nil),
+			expected: NewPeriod(time.Date(2019, 3, 10, 0, 0, 0, 0, time.UTC), time.Date(2019, 3, 11, 0, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "week starting on Monday",
+			result:   WeekOf(tm, time.Monday, chiTz),
+			expected: NewPeriod(time.Date(2019, 3, 4, 0, 0, 0, 0, chiTz), time.Date(2019, 3, 11, 0, 0, 0, 0, chiTz)),
+		}, {
+			name:     "week starting on Sunday",
+			result:   WeekOf(tm, time.Sunday, chiTz),
+			expected: NewPeriod(time.Date(2019, 3, 10, 0, 0, 0, 0, chiTz), time.Date(2019, 3, 17, 0, 0, 0, 0, chiTz)),
+		}, {
+			name:     "month",
+			result:   MonthOf(tm, chiTz),
+			expected: NewPeriod(time.Date(2019, 3, 1, 0, 0, 0, 0, chiTz), time.Date(2019, 4, 1, 0, 0, 0, 0, chiTz)),
+		}, {
+			name:     "quarter",
+			result:   QuarterOf(tm, chiTz),
+			expected: NewPeriod(time.Date(2019, 1, 1, 0, 0, 0, 0, chiTz), time.Date(2019, 4, 1, 0, 0, 0, 0, chiTz)),
+		}, {
+			name:     "year",
+			result:   YearOf(tm, chiTz),
+			expected: NewPeriod(time.Date(2019, 1, 1, 0, 0, 0, 0, chiTz), time.Date(2020, 1, 1, 0, 0, 0, 0, chiTz)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.True(t, test.expected.Equals(test.result), "expected %v, got %v", test.expected, test.result)
+		})
+	}
+}
+
+func TestISOWeek(t *testing.T) {
+	nyTz, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	tests := []struct {
+		name        string
+		expected    Period
+		year, week  int
+		expectError bool
+	}{
+		{
+			name:     "week 42 of 2026",
+			year:     2026,
+			week:     42,
+			expected: NewPeriod(time.Date(2026, 10, 12, 0, 0, 0, 0, nyTz), time.Date(2026, 10, 19, 0, 0, 0, 0, nyTz)),
+		}, {
+			name:     "week 1 may begin in the previous calendar year",
+			year:     2020,
+			week:     1,
+			expected: NewPeriod(time.Date(2019, 12, 30, 0, 0, 0, 0, nyTz), time.Date(2020, 1, 6, 0, 0, 0, 0, nyTz)),
+		}, {
+			name:     "week 53 of a 53 week year",
+			year:     2020,
+			week:     53,
+			expected: NewPeriod(time.Date(2020, 12, 28, 0, 0, 0, 0, nyTz), time.Date(2021, 1, 4, 0, 0, 0, 0, nyTz)),
+		}, {
+			name:        "week 53 of a 52 week year",
+			year:        2019,
+			week:        53,
+			expectError: true,
+		}, {
+			name:        "week 0",
+			year:        2019,
+			week:        0,
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var result Period
+			result, err = ISOWeek(test.year, test.week, nyTz)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
+
+func TestNextCalendar(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	day := DayOf(time.Date(2019, 3, 9, 12, 0, 0, 0, chiTz), chiTz)
+	assert.True(t, DayOf(time.Date(2019, 3, 10, 12, 0, 0, 0, chiTz), chiTz).Equals(NextCalendar(day, UnitDay, chiTz)))
+	week := WeekOf(time.Date(2019, 3, 6, 12, 0, 0, 0, chiTz), time.Monday, chiTz)
+	assert.True(t, NewPeriod(
+		time.Date(2019, 3, 11, 0, 0, 0, 0, chiTz), time.Date(2019, 3, 18, 0, 0, 0, 0, chiTz),
+	).Equals(NextCalendar(week, UnitWeek, chiTz)))
+	month := MonthOf(time.Date(2019, 1, 31, 12, 0, 0, 0, time.UTC), nil)
+	assert.Equal(
+		t,
+		NewPeriod(time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)),
+		NextCalendar(month, UnitMonth, nil))
+}
+
+func TestPrevCalendar(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	day := DayOf(time.Date(2019, 11, 4, 12, 0, 0, 0, chiTz), chiTz)
+	prev := PrevCalendar(day, UnitDay, chiTz)
+	assert.True(t, DayOf(time.Date(2019, 11, 3, 12, 0, 0, 0, chiTz), chiTz).Equals(prev))
+	assert.Equal(t, 25*time.Hour, prev.Duration())
+	quarter := QuarterOf(time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), nil)
+	assert.Equal(
+		t,
+		NewPeriod(time.Date(2018, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)),
+		PrevCalendar(quarter, UnitQuarter, nil))
+}
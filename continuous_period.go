@@ -48,6 +48,54 @@ func NewContinuousPeriod(start, end time.Duration, startDow, endDow time.Weekday
 	}
 }
 
+// ContinuousPeriodConstructionError is the error type returned if there is a problem constructing a ContinuousPeriod
+type ContinuousPeriodConstructionError string
+
+// Error implements the error interface for ContinuousPeriodConstructionError
+func (c ContinuousPeriodConstructionError) Error() string {
+	return string(c)
+}
+
+// NewContinuousPeriodFromTimeOfDay constructs a new continuous period from start and end times of day. Unlike
+// NewContinuousPeriod, an error is returned if either time is not between 00:00 and 24:00.
+func NewContinuousPeriodFromTimeOfDay(
+	start, end TimeOfDay, startDow, endDow time.Weekday, location *time.Location,
+) (ContinuousPeriod, error) {
+	if !start.Valid() || !end.Valid() {
+		return ContinuousPeriod{}, ContinuousPeriodConstructionError("continuous period start and end must be valid times of day")
+	}
+	return NewContinuousPeriod(start.Duration(), end.Duration(), startDow, endDow, location), nil
+}
+
+// StartTimeOfDay returns the time of day on the start day of week that the period begins.
+func (cp ContinuousPeriod) StartTimeOfDay() TimeOfDay {
+	return TimeOfDay(cp.Start)
+}
+
+// EndTimeOfDay returns the time of day on the end day of week that the period ends.
+func (cp ContinuousPeriod) EndTimeOfDay() TimeOfDay {
+	return TimeOfDay(cp.End)
+}
+
+// NewContinuousPeriodFromWeekTime constructs a new continuous period that begins at the start week time and ends at
+// the end week time. An error is returned if either week time is not valid.
+func NewContinuousPeriodFromWeekTime(start, end WeekTime, location *time.Location) (ContinuousPeriod, error) {
+	if !start.Valid() || !end.Valid() {
+		return ContinuousPeriod{}, ContinuousPeriodConstructionError("continuous period start and end must be valid week times")
+	}
+	return NewContinuousPeriod(start.TimeOfDay.Duration(), end.TimeOfDay.Duration(), start.Weekday, end.Weekday, location), nil
+}
+
+// StartWeekTime returns the day of the week and time of day that the period begins.
+func (cp ContinuousPeriod) StartWeekTime() WeekTime {
+	return WeekTime{Weekday: cp.StartDOW, TimeOfDay: cp.StartTimeOfDay()}
+}
+
+// EndWeekTime returns the day of the week and time of day that the period ends.
+func (cp ContinuousPeriod) EndWeekTime() WeekTime {
+	return WeekTime{Weekday: cp.EndDOW, TimeOfDay: cp.EndTimeOfDay()}
+}
+
 // AtDate returns the ContinuousPeriod offset around the given date. If the date given is contained in a continuous
 // period, the period containing d is the period that is returned. If the date given is not contained in a
 // continuous period, the period that is returned is the next occurrence of the continuous period. Note that
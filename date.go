@@ -0,0 +1,243 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+)
+
+// dateLayout is the layout used to format and parse a Date
+const dateLayout = "2006-01-02"
+
+// Date is a civil calendar date, such as an event date or a blackout date, that is not tied to a location. Unlike a
+// time.Time at midnight, a Date does not change when viewed from another time zone; use In or DateRange.ToPeriod to
+// find when a Date begins in a particular location.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate constructs a new Date. Values outside of their usual ranges are normalized in the same way as time.Date,
+// so for example October 32 becomes November 1.
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the Date on which t falls in its location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// ParseDate parses a Date in the format "2006-01-02".
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: %w", s, err)
+	}
+	return DateOf(t), nil
+}
+
+// String returns the Date in the format "2006-01-02".
+func (d Date) String() string {
+	return d.midnight().Format(dateLayout)
+}
+
+// IsZero returns whether the Date is the zero value.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// Weekday returns the day of the week of the Date.
+func (d Date) Weekday() time.Weekday {
+	return d.midnight().Weekday()
+}
+
+// AddDays returns the Date n days after the Date, or before it if n is negative.
+func (d Date) AddDays(n int) Date {
+	return NewDate(d.Year, d.Month, d.Day+n)
+}
+
+// DaysUntil returns the number of days from the Date to the other date, which is negative if other is before the
+// Date.
+func (d Date) DaysUntil(other Date) int {
+	return int(other.midnight().Sub(d.midnight()) / (HoursInDay * time.Hour))
+}
+
+// Compare returns -1 if the Date is before other, 1 if it is after other and 0 if they are the same date.
+func (d Date) Compare(other Date) int {
+	if c := cmp.Compare(d.Year, other.Year); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(d.Month, other.Month); c != 0 {
+		return c
+	}
+	return cmp.Compare(d.Day, other.Day)
+}
+
+// Before returns whether the Date is before the other date.
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// After returns whether the Date is after the other date.
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+// In returns the first instant of the Date in the given location. This is usually midnight, but in locations where
+// a daylight saving time transition skips over midnight, the day begins at the end of the transition instead. If loc
+// is nil, UTC is used.
+func (d Date) In(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return startOfDay(d.Year, d.Month, d.Day, loc)
+}
+
+// midnight returns midnight UTC on the Date, which is used for date arithmetic.
+func (d Date) midnight() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// DateRange is a range of civil calendar dates that includes both its Start and End dates. A DateRange whose End is
+// before its Start is empty.
+type DateRange struct {
+	Start Date
+	End   Date
+}
+
+// NewDateRange constructs a new DateRange from start to end, including both.
+func NewDateRange(start, end Date) DateRange {
+	return DateRange{Start: start, End: end}
+}
+
+// String returns the DateRange in interval notation, eg "[2019-01-01, 2019-01-31]".
+func (dr DateRange) String() string {
+	return fmt.Sprintf("[%s, %s]", dr.Start, dr.End)
+}
+
+// IsEmpty returns whether the DateRange contains no dates.
+func (dr DateRange) IsEmpty() bool {
+	return dr.End.Before(dr.Start)
+}
+
+// Days returns the number of dates in the DateRange.
+func (dr DateRange) Days() int {
+	if dr.IsEmpty() {
+		return 0
+	}
+	return dr.Start.DaysUntil(dr.End) + 1
+}
+
+// Contains returns whether the date is in the DateRange.
+func (dr DateRange) Contains(d Date) bool {
+	return !d.Before(dr.Start) && !d.After(dr.End)
+}
+
+// Dates returns every date in the DateRange in order.
+func (dr DateRange) Dates() []Date {
+	return dr.DatesOn(ApplicableDays{true, true, true, true, true, true, true})
+}
+
+// DatesOn returns the dates in the DateRange that fall on one of the applicable days, in order.
+func (dr DateRange) DatesOn(days ApplicableDays) []Date {
+	dates := make([]Date, 0, dr.Days())
+	for d := dr.Start; !d.After(dr.End); d = d.AddDays(1) {
+		if days.DayApplicable(d.Weekday()) {
+			dates = append(dates, d)
+		}
+	}
+	return dates
+}
+
+// Intersect returns the dates that are in both the DateRange and the other range, and whether there are any.
+func (dr DateRange) Intersect(other DateRange) (DateRange, bool) {
+	result := DateRange{Start: maxDate(dr.Start, other.Start), End: minDate(dr.End, other.End)}
+	if result.IsEmpty() {
+		return DateRange{}, false
+	}
+	return result, true
+}
+
+// Union returns the dates that are in either the DateRange or the other range. The result is a single range if the
+// ranges overlap or are adjacent, and otherwise the two ranges in order. Empty ranges are omitted.
+func (dr DateRange) Union(other DateRange) []DateRange {
+	switch {
+	case dr.IsEmpty() && other.IsEmpty():
+		return []DateRange{}
+	case dr.IsEmpty():
+		return []DateRange{other}
+	case other.IsEmpty():
+		return []DateRange{dr}
+	}
+	first, second := dr, other
+	if second.Start.Before(first.Start) {
+		first, second = second, first
+	}
+	if second.Start.After(first.End.AddDays(1)) {
+		return []DateRange{first, second}
+	}
+	return []DateRange{{Start: first.Start, End: maxDate(first.End, second.End)}}
+}
+
+// Subtract returns the dates in the DateRange that are not in the other range, which may be split into two ranges.
+func (dr DateRange) Subtract(other DateRange) []DateRange {
+	result := make([]DateRange, 0, 2)
+	if dr.IsEmpty() {
+		return result
+	}
+	if _, ok := dr.Intersect(other); !ok {
+		return append(result, dr)
+	}
+	if before := (DateRange{Start: dr.Start, End: other.Start.AddDays(-1)}); !before.IsEmpty() {
+		result = append(result, before)
+	}
+	if after := (DateRange{Start: other.End.AddDays(1), End: dr.End}); !after.IsEmpty() {
+		result = append(result, after)
+	}
+	return result
+}
+
+// ToPeriod returns the Period from the beginning of the first date in the DateRange to the beginning of the day after
+// the last date in the given location. Because each day begins at local midnight, days with a daylight saving time
+// transition are 23 or 25 hours long. An empty DateRange returns an empty period at the beginning of its start
+// date. If loc is nil, UTC is used.
+func (dr DateRange) ToPeriod(loc *time.Location) Period {
+	start := dr.Start.In(loc)
+	if dr.IsEmpty() {
+		return NewPeriod(start, start)
+	}
+	return NewPeriod(start, dr.End.AddDays(1).In(loc))
+}
+
+// minDate returns the earlier of two dates.
+func minDate(a, b Date) Date {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// maxDate returns the later of two dates.
+func maxDate(a, b Date) Date {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
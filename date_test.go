@@ -0,0 +1,164 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	d := NewDate(2019, 10, 32)
+	assert.Equal(t, Date{Year: 2019, Month: time.November, Day: 1}, d)
+	assert.Equal(t, "2019-11-01", d.String())
+	assert.Equal(t, time.Friday, d.Weekday())
+	assert.Equal(t, NewDate(2020, 1, 1), d.AddDays(61))
+	assert.Equal(t, -31, d.DaysUntil(NewDate(2019, 10, 1)))
+	assert.True(t, d.After(NewDate(2019, 10, 31)))
+	assert.True(t, d.Before(NewDate(2019, 11, 2)))
+	assert.Equal(t, 0, d.Compare(NewDate(2019, 11, 1)))
+	assert.True(t, Date{}.IsZero())
+	assert.False(t, d.IsZero())
+	// the date of a time depends on its location
+	assert.Equal(t, NewDate(2019, 10, 31), DateOf(time.Date(2019, 11, 1, 3, 0, 0, 0, time.UTC).In(chiTz)))
+	assert.True(t, time.Date(2019, 11, 1, 0, 0, 0, 0, chiTz).Equal(d.In(chiTz)))
+	assert.True(t, time.Date(2019, 11, 1, 0, 0, 0, 0, time.UTC).Equal(d.In(nil)))
+}
+
+func TestParseDate(t *testing.T) {
+	d, err := ParseDate("2019-03-10")
+	require.NoError(t, err)
+	assert.Equal(t, NewDate(2019, 3, 10), d)
+	_, err = ParseDate("2019-02-30")
+	assert.Error(t, err)
+}
+
+func TestDateRange_Days(t *testing.T) {
+	dr := NewDateRange(NewDate(2019, 1, 30), NewDate(2019, 2, 2))
+	assert.Equal(t, 4, dr.Days())
+	assert.Equal(t, []Date{NewDate(2019, 1, 30), NewDate(2019, 1, 31), NewDate(2019, 2, 1), NewDate(2019, 2, 2)}, dr.Dates())
+	assert.True(t, dr.Contains(NewDate(2019, 2, 2)))
+	assert.False(t, dr.Contains(NewDate(2019, 2, 3)))
+	assert.Equal(t, "[2019-01-30, 2019-02-02]", dr.String())
+	empty := NewDateRange(NewDate(2019, 2, 2), NewDate(2019, 2, 1))
+	assert.True(t, empty.IsEmpty())
+	assert.Equal(t, 0, empty.Days())
+	assert.Empty(t, empty.Dates())
+}
+
+func TestDateRange_DatesOn(t *testing.T) {
+	dr := NewDateRange(NewDate(2019, 1, 1), NewDate(2019, 1, 14))
+	assert.Equal(
+		t,
+		[]Date{NewDate(2019, 1, 5), NewDate(2019, 1, 6), NewDate(2019, 1, 12), NewDate(2019, 1, 13)},
+		dr.DatesOn(ApplicableDays{Saturday: true, Sunday: true}))
+	assert.Empty(t, dr.DatesOn(ApplicableDays{}))
+}
+
+func TestDateRange_Intersect(t *testing.T) {
+	dr := NewDateRange(NewDate(2019, 1, 1), NewDate(2019, 1, 10))
+	result, ok := dr.Intersect(NewDateRange(NewDate(2019, 1, 10), NewDate(2019, 1, 20)))
+	assert.True(t, ok)
+	assert.Equal(t, NewDateRange(NewDate(2019, 1, 10), NewDate(2019, 1, 10)), result)
+	_, ok = dr.Intersect(NewDateRange(NewDate(2019, 1, 11), NewDate(2019, 1, 20)))
+	assert.False(t, ok)
+}
+
+func TestDateRange_Union(t *testing.T) {
+	dr := NewDateRange(NewDate(2019, 1, 1), NewDate(2019, 1, 10))
+	tests := []struct {
+		name     string
+		expected []DateRange
+		other    DateRange
+	}{
+		{
+			name:     "overlapping ranges are merged",
+			other:    NewDateRange(NewDate(2019, 1, 5), NewDate(2019, 1, 20)),
+			expected: []DateRange{NewDateRange(NewDate(2019, 1, 1), NewDate(2019, 1, 20))},
+		}, {
+			name:     "adjacent ranges are merged",
+			other:    NewDateRange(NewDate(2019, 1, 11), NewDate(2019, 1, 20)),
+			expected: []DateRange{NewDateRange(NewDate(2019, 1, 1), NewDate(2019, 1, 20))},
+		}, {
+			name:  "separate ranges are returned in order",
+			other: NewDateRange(NewDate(2018, 12, 1), NewDate(2018, 12, 30)),
+			expected: []DateRange{
+				NewDateRange(NewDate(2018, 12, 1), NewDate(2018, 12, 30)),
+				NewDateRange(NewDate(2019, 1, 1), NewDate(2019, 1, 10)),
+			},
+		}, {
+			name:     "empty ranges are omitted",
+			other:    NewDateRange(NewDate(2019, 2, 1), NewDate(2019, 1, 1)),
+			expected: []DateRange{dr},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, dr.Union(test.other))
+			assert.Equal(t, test.expected, test.other.Union(dr))
+		})
+	}
+}
+
+func TestDateRange_Subtract(t *testing.T) {
+	dr := NewDateRange(NewDate(2019, 1, 1), NewDate(2019, 1, 10))
+	tests := []struct {
+		name     string
+		expected []DateRange
+		other    DateRange
+	}{
+		{
+			name:  "range within is removed from the middle",
+			other: NewDateRange(NewDate(2019, 1, 4), NewDate(2019, 1, 6)),
+			expected: []DateRange{
+				NewDateRange(NewDate(2019, 1, 1), NewDate(2019, 1, 3)),
+				NewDateRange(NewDate(2019, 1, 7), NewDate(2019, 1, 10)),
+			},
+		}, {
+			name:     "overlapping range is removed from the end",
+			other:    NewDateRange(NewDate(2019, 1, 10), NewDate(2019, 1, 20)),
+			expected: []DateRange{NewDateRange(NewDate(2019, 1, 1), NewDate(2019, 1, 9))},
+		}, {
+			name:     "disjoint range removes nothing",
+			other:    NewDateRange(NewDate(2019, 1, 11), NewDate(2019, 1, 20)),
+			expected: []DateRange{dr},
+		}, {
+			name:     "covering range removes everything",
+			other:    NewDateRange(NewDate(2018, 1, 1), NewDate(2020, 1, 1)),
+			expected: []DateRange{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, dr.Subtract(test.other))
+		})
+	}
+}
+
+func TestDateRange_ToPeriod(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	p := NewDateRange(NewDate(2019, 3, 9), NewDate(2019, 3, 10)).ToPeriod(chiTz)
+	assert.True(t, NewPeriod(time.Date(2019, 3, 9, 0, 0, 0, 0, chiTz), time.Date(2019, 3, 11, 0, 0, 0, 0, chiTz)).Equals(p))
+	assert.Equal(t, 47*time.Hour, p.Duration())
+	empty := NewDateRange(NewDate(2019, 3, 9), NewDate(2019, 3, 8)).ToPeriod(nil)
+	assert.True(t, empty.IsZero())
+	assert.True(t, time.Date(2019, 3, 9, 0, 0, 0, 0, time.UTC).Equal(empty.Start))
+}
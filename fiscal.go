@@ -0,0 +1,223 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"fmt"
+	"time"
+)
+
+// FiscalYearEnd is the rule that determines the last day of a 52/53 week fiscal year.
+type FiscalYearEnd int
+
+const (
+	// FiscalYearEndLast ends the fiscal year on the last occurrence of the end weekday in the end month
+	FiscalYearEndLast FiscalYearEnd = iota
+	// FiscalYearEndNearest ends the fiscal year on the occurrence of the end weekday nearest to the last day of the end
+	// month, which may fall in the following month
+	FiscalYearEndNearest
+)
+
+// FiscalPattern is the number of weeks in each of the three fiscal periods in a fiscal quarter.
+type FiscalPattern int
+
+const (
+	// Pattern445 divides each quarter into periods of 4, 4 and 5 weeks
+	Pattern445 FiscalPattern = iota
+	// Pattern454 divides each quarter into periods of 4, 5 and 4 weeks
+	Pattern454
+	// Pattern544 divides each quarter into periods of 5, 4 and 4 weeks
+	Pattern544
+)
+
+const (
+	// weeksInFiscalQuarter is the number of weeks in a fiscal quarter, not including a 53rd week
+	weeksInFiscalQuarter = 13
+	// periodsInFiscalQuarter is the number of fiscal periods in a fiscal quarter
+	periodsInFiscalQuarter = 3
+	// quartersInFiscalYear is the number of quarters in a fiscal year
+	quartersInFiscalYear = 4
+	// weeksInFiscalYear is the number of weeks in a fiscal year without a 53rd week
+	weeksInFiscalYear = quartersInFiscalYear * weeksInFiscalQuarter
+)
+
+// weeks returns the number of weeks in each period of a quarter.
+func (fp FiscalPattern) weeks() [periodsInFiscalQuarter]int {
+	switch fp {
+	case Pattern454:
+		return [periodsInFiscalQuarter]int{4, 5, 4}
+	case Pattern544:
+		return [periodsInFiscalQuarter]int{5, 4, 4}
+	}
+	return [periodsInFiscalQuarter]int{4, 4, 5}
+}
+
+// FiscalCalendar is a 52/53 week fiscal calendar, as is commonly used in retail, in which every fiscal year ends on
+// the same day of the week. Each fiscal year consists of 4 quarters of 13 weeks, and each quarter is divided into 3
+// fiscal periods according to a FiscalPattern. Since 52 weeks are a day or two short of a calendar year, a 53rd
+// week is added to the last period of the year every 5 or 6 years.
+//
+// Fiscal years are numbered by the calendar year in which they end. Weeks begin on the day after the end weekday and
+// every fiscal year, quarter, period and week begins at local midnight in the calendar's location.
+type FiscalCalendar struct {
+	// Location in which the fiscal calendar's days begin
+	Location *time.Location
+	// Month in which the fiscal year ends, or nearly ends for FiscalYearEndNearest
+	EndMonth time.Month
+	// Day of the week on which the fiscal year ends
+	EndWeekday time.Weekday
+	// Rule that determines on which occurrence of EndWeekday the fiscal year ends
+	YearEnd FiscalYearEnd
+	// Number of weeks in each fiscal period of a quarter
+	Pattern FiscalPattern
+}
+
+// FiscalCalendarConstructionError is the error type returned if there is a problem constructing a FiscalCalendar
+type FiscalCalendarConstructionError string
+
+// Error implements the error interface for FiscalCalendarConstructionError
+func (f FiscalCalendarConstructionError) Error() string {
+	return string(f)
+}
+
+// NewFiscalCalendar constructs a new fiscal calendar whose fiscal years end on the given weekday at the end of the
+// given month, according to the year end rule. For example, the National Retail Federation's 4-5-4 calendar ends on
+// the Saturday nearest the end of January:
+//
+//	NewFiscalCalendar(time.January, time.Saturday, FiscalYearEndNearest, Pattern454, loc)
+func NewFiscalCalendar(
+	endMonth time.Month, endWeekday time.Weekday, yearEnd FiscalYearEnd, pattern FiscalPattern, location *time.Location,
+) (FiscalCalendar, error) {
+	if endMonth < time.January || endMonth > time.December {
+		return FiscalCalendar{}, FiscalCalendarConstructionError(fmt.Sprintf("invalid fiscal year end month %d", endMonth))
+	}
+	if endWeekday < time.Sunday || endWeekday > time.Saturday {
+		return FiscalCalendar{}, FiscalCalendarConstructionError(fmt.Sprintf("invalid fiscal year end weekday %d", endWeekday))
+	}
+	if yearEnd != FiscalYearEndLast && yearEnd != FiscalYearEndNearest {
+		return FiscalCalendar{}, FiscalCalendarConstructionError(fmt.Sprintf("invalid fiscal year end rule %d", yearEnd))
+	}
+	if pattern < Pattern445 || pattern > Pattern544 {
+		return FiscalCalendar{}, FiscalCalendarConstructionError(fmt.Sprintf("invalid fiscal pattern %d", pattern))
+	}
+	l := location
+	if location == nil {
+		l = time.UTC
+	}
+	return FiscalCalendar{
+		Location:   l,
+		EndMonth:   endMonth,
+		EndWeekday: endWeekday,
+		YearEnd:    yearEnd,
+		Pattern:    pattern,
+	}, nil
+}
+
+// Year returns the given fiscal year.
+func (fc FiscalCalendar) Year(year int) Period {
+	return NewPeriod(fc.yearStart(year), fc.yearStart(year+1))
+}
+
+// YearOf returns the number of the fiscal year containing t.
+func (fc FiscalCalendar) YearOf(t time.Time) int {
+	year := t.In(fc.location()).Year()
+	for t.Before(fc.yearStart(year)) {
+		year--
+	}
+	for !t.Before(fc.yearStart(year + 1)) {
+		year++
+	}
+	return year
+}
+
+// WeeksInYear returns the number of weeks in the given fiscal year, which is either 52 or 53.
+func (fc FiscalCalendar) WeeksInYear(year int) int {
+	days := fc.lastDay(year).Sub(fc.lastDay(year-1)) / (HoursInDay * time.Hour)
+	return int(days) / DaysInWeek
+}
+
+// Quarter returns the given quarter, from 1 to 4, of the given fiscal year. The last quarter of a 53 week year is 14
+// weeks long. An error is returned if the quarter does not exist.
+func (fc FiscalCalendar) Quarter(year, quarter int) (Period, error) {
+	if quarter < 1 || quarter > quartersInFiscalYear {
+		return Period{}, fmt.Errorf("fiscal year %d does not have a quarter %d", year, quarter)
+	}
+	weeks := weeksInFiscalQuarter
+	if quarter == quartersInFiscalYear {
+		weeks += fc.WeeksInYear(year) - weeksInFiscalYear
+	}
+	return fc.weeks(year, (quarter-1)*weeksInFiscalQuarter, weeks), nil
+}
+
+// Period returns the given fiscal period, from 1 to 12, of the given fiscal year. The length of each period is
+// determined by the calendar's Pattern, and the last period of a 53 week year includes the 53rd week. An error is
+// returned if the period does not exist.
+func (fc FiscalCalendar) Period(year, period int) (Period, error) {
+	if period < 1 || period > quartersInFiscalYear*periodsInFiscalQuarter {
+		return Period{}, fmt.Errorf("fiscal year %d does not have a period %d", year, period)
+	}
+	pattern := fc.Pattern.weeks()
+	quarter, position := (period-1)/periodsInFiscalQuarter, (period-1)%periodsInFiscalQuarter
+	first := quarter * weeksInFiscalQuarter
+	for _, weeks := range pattern[:position] {
+		first += weeks
+	}
+	weeks := pattern[position]
+	if period == quartersInFiscalYear*periodsInFiscalQuarter {
+		weeks += fc.WeeksInYear(year) - weeksInFiscalYear
+	}
+	return fc.weeks(year, first, weeks), nil
+}
+
+// Week returns the given week, from 1 to 52 or 53, of the given fiscal year. An error is returned if the week does
+// not exist.
+func (fc FiscalCalendar) Week(year, week int) (Period, error) {
+	if week < 1 || week > fc.WeeksInYear(year) {
+		return Period{}, fmt.Errorf("fiscal year %d does not have a week %d", year, week)
+	}
+	return fc.weeks(year, week-1, 1), nil
+}
+
+// weeks returns the period spanning count weeks beginning with the given zero-indexed week of the fiscal year.
+func (fc FiscalCalendar) weeks(year, first, count int) Period {
+	start := fc.yearStart(year)
+	return NewPeriod(addUnits(start, UnitWeek, first), addUnits(start, UnitWeek, first+count))
+}
+
+// yearStart returns the first instant of the given fiscal year, which is midnight on the day after the previous
+// fiscal year's last day.
+func (fc FiscalCalendar) yearStart(year int) time.Time {
+	last := fc.lastDay(year - 1)
+	return startOfDay(last.Year(), last.Month(), last.Day()+1, fc.location())
+}
+
+// lastDay returns the date of the last day of the given fiscal year as midnight UTC.
+func (fc FiscalCalendar) lastDay(year int) time.Time {
+	monthEnd := time.Date(year, fc.EndMonth+1, 0, 0, 0, 0, 0, time.UTC)
+	// back is the number of days from the last end weekday in the month to the end of the month
+	back := (int(monthEnd.Weekday()) - int(fc.EndWeekday) + DaysInWeek) % DaysInWeek
+	if fc.YearEnd == FiscalYearEndNearest && back > DaysInWeek/2 {
+		return monthEnd.AddDate(0, 0, DaysInWeek-back)
+	}
+	return monthEnd.AddDate(0, 0, -back)
+}
+
+// location returns the location of the fiscal calendar, defaulting to UTC.
+func (fc FiscalCalendar) location() *time.Location {
+	if fc.Location == nil {
+		return time.UTC
+	}
+	return fc.Location
+}
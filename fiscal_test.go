@@ -0,0 +1,212 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nrfCalendar returns the National Retail Federation's 4-5-4 calendar, which ends on the Saturday nearest the end of
+// January
+func nrfCalendar(t *testing.T) FiscalCalendar {
+	fc, err := NewFiscalCalendar(time.January, time.Saturday, FiscalYearEndNearest, Pattern454, time.UTC)
+	require.NoError(t, err)
+	return fc
+}
+
+func TestNewFiscalCalendar(t *testing.T) {
+	tests := []struct {
+		name       string
+		endMonth   time.Month
+		endWeekday time.Weekday
+		yearEnd    FiscalYearEnd
+		pattern    FiscalPattern
+	}{
+		{name: "invalid month", endMonth: 13, endWeekday: time.Saturday},
+		{name: "invalid weekday", endMonth: time.January, endWeekday: 7},
+		{name: "invalid year end rule", endMonth: time.January, endWeekday: time.Saturday, yearEnd: 2},
+		{name: "invalid pattern", endMonth: time.January, endWeekday: time.Saturday, pattern: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fc, err := NewFiscalCalendar(test.endMonth, test.endWeekday, test.yearEnd, test.pattern, nil)
+			assert.IsType(t, FiscalCalendarConstructionError(""), err)
+			assert.Equal(t, FiscalCalendar{}, fc)
+		})
+	}
+	fc, err := NewFiscalCalendar(time.December, time.Sunday, FiscalYearEndLast, Pattern445, nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, fc.Location)
+}
+
+func TestFiscalCalendar_Year(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	nrf := nrfCalendar(t)
+	lastSaturday, err := NewFiscalCalendar(time.December, time.Saturday, FiscalYearEndLast, Pattern445, chiTz)
+	require.NoError(t, err)
+	tests := []struct {
+		expected Period
+		name     string
+		fc       FiscalCalendar
+		year     int
+		weeks    int
+	}{
+		{
+			name:     "52 week year ending on the Saturday nearest the end of January",
+			fc:       nrf,
+			year:     2020,
+			expected: NewPeriod(time.Date(2019, 2, 3, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 2, 0, 0, 0, 0, time.UTC)),
+			weeks:    52,
+		}, {
+			name:     "53 week year ending on the Saturday nearest the end of January",
+			fc:       nrf,
+			year:     2018,
+			expected: NewPeriod(time.Date(2017, 1, 29, 0, 0, 0, 0, time.UTC), time.Date(2018, 2, 4, 0, 0, 0, 0, time.UTC)),
+			weeks:    53,
+		}, {
+			name:     "year ending on the last Saturday of December",
+			fc:       lastSaturday,
+			year:     2019,
+			expected: NewPeriod(time.Date(2018, 12, 30, 0, 0, 0, 0, chiTz), time.Date(2019, 12, 29, 0, 0, 0, 0, chiTz)),
+			weeks:    52,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.fc.Year(test.year)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+			assert.Equal(t, test.weeks, test.fc.WeeksInYear(test.year))
+		})
+	}
+}
+
+func TestFiscalCalendar_YearOf(t *testing.T) {
+	fc := nrfCalendar(t)
+	assert.Equal(t, 2020, fc.YearOf(time.Date(2019, 2, 3, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 2019, fc.YearOf(time.Date(2019, 2, 2, 23, 59, 59, 0, time.UTC)))
+	assert.Equal(t, 2020, fc.YearOf(time.Date(2020, 2, 1, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 2021, fc.YearOf(time.Date(2020, 2, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestFiscalCalendar_Quarter(t *testing.T) {
+	fc := nrfCalendar(t)
+	tests := []struct {
+		name        string
+		expected    Period
+		year        int
+		quarter     int
+		expectError bool
+	}{
+		{
+			name:     "first quarter",
+			year:     2018,
+			quarter:  1,
+			expected: NewPeriod(time.Date(2017, 1, 29, 0, 0, 0, 0, time.UTC), time.Date(2017, 4, 30, 0, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "last quarter of a 53 week year has 14 weeks",
+			year:     2018,
+			quarter:  4,
+			expected: NewPeriod(time.Date(2017, 10, 29, 0, 0, 0, 0, time.UTC), time.Date(2018, 2, 4, 0, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "quarter 5",
+			year:        2018,
+			quarter:     5,
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := fc.Quarter(test.year, test.quarter)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
+
+func TestFiscalCalendar_Period(t *testing.T) {
+	tests := []struct {
+		name          string
+		expectedWeeks []int
+		pattern       FiscalPattern
+		year          int
+	}{
+		{
+			name:          "4-4-5 pattern",
+			pattern:       Pattern445,
+			year:          2020,
+			expectedWeeks: []int{4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5},
+		}, {
+			name:          "4-5-4 pattern",
+			pattern:       Pattern454,
+			year:          2020,
+			expectedWeeks: []int{4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 4},
+		}, {
+			name:          "5-4-4 pattern with a 53rd week in the last period",
+			pattern:       Pattern544,
+			year:          2018,
+			expectedWeeks: []int{5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 5},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fc, err := NewFiscalCalendar(time.January, time.Saturday, FiscalYearEndNearest, test.pattern, time.UTC)
+			require.NoError(t, err)
+			start := fc.Year(test.year).Start
+			var result Period
+			for i, weeks := range test.expectedWeeks {
+				result, err = fc.Period(test.year, i+1)
+				require.NoError(t, err)
+				assert.True(t, start.Equal(result.Start), "period %d starts at %v", i+1, result.Start)
+				assert.Equal(t, time.Duration(weeks*DaysInWeek*HoursInDay)*time.Hour, result.Duration(), "period %d", i+1)
+				start = result.End
+			}
+			assert.True(t, fc.Year(test.year).End.Equal(start))
+		})
+	}
+	_, err := nrfCalendar(t).Period(2020, 13)
+	assert.Error(t, err)
+}
+
+func TestFiscalCalendar_Week(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	fc, err := NewFiscalCalendar(time.January, time.Saturday, FiscalYearEndNearest, Pattern454, chiTz)
+	require.NoError(t, err)
+	week, err := fc.Week(2019, 6)
+	require.NoError(t, err)
+	// the week containing the start of daylight saving time is an hour short
+	assert.Equal(t, 167*time.Hour, week.Duration())
+	assert.True(t, NewPeriod(
+		time.Date(2018, 3, 11, 0, 0, 0, 0, chiTz), time.Date(2018, 3, 18, 0, 0, 0, 0, chiTz),
+	).Equals(week), "got %v", week)
+	week, err = fc.Week(2018, 53)
+	require.NoError(t, err)
+	assert.True(t, NewPeriod(
+		time.Date(2018, 1, 28, 0, 0, 0, 0, chiTz), time.Date(2018, 2, 4, 0, 0, 0, 0, chiTz),
+	).Equals(week), "got %v", week)
+	_, err = fc.Week(2019, 53)
+	assert.Error(t, err)
+	_, err = fc.Week(2019, 0)
+	assert.Error(t, err)
+}
@@ -60,6 +60,27 @@ func NewFloatingPeriod(start, end time.Duration, days ApplicableDays, location *
 	}, nil
 }
 
+// NewFloatingPeriodFromTimeOfDay constructs a new floating period from start and end times of day. Unlike
+// NewFloatingPeriod, an error is also returned if either time is not between 00:00 and 24:00.
+func NewFloatingPeriodFromTimeOfDay(
+	start, end TimeOfDay, days ApplicableDays, location *time.Location, endInclusive bool,
+) (FloatingPeriod, error) {
+	if !start.Valid() || !end.Valid() {
+		return FloatingPeriod{}, FloatingPeriodConstructionError("floating period start and end must be valid times of day")
+	}
+	return NewFloatingPeriod(start.Duration(), end.Duration(), days, location, endInclusive)
+}
+
+// StartTimeOfDay returns the time of day that the period begins.
+func (fp FloatingPeriod) StartTimeOfDay() TimeOfDay {
+	return TimeOfDay(fp.Start)
+}
+
+// EndTimeOfDay returns the time of day that the period ends.
+func (fp FloatingPeriod) EndTimeOfDay() TimeOfDay {
+	return TimeOfDay(fp.End)
+}
+
 // Contiguous returns true if starts time is equal to end time. It does not consider applicable
 // days.
 func (fp FloatingPeriod) Contiguous() bool {
@@ -69,7 +90,8 @@ func (fp FloatingPeriod) Contiguous() bool {
 // AtDate returns the FloatingPeriod offset around the given date. If the date given is contained in a floating
 // period, the period containing the date is the period that is returned. If the date given is not contained in a
 // floating period, the period that is returned is the next occurrence of the floating period. Note that
-// containment is inclusive on the continuous period start time but not on the end time.
+// containment is inclusive on the continuous period start time and only inclusive on the end time if
+// EndInclusive is set, which is reflected in the Bounds of the returned period.
 func (fp FloatingPeriod) AtDate(date time.Time) Period {
 	dateInLoc := date.In(fp.Location)
 	midnight := time.Date(dateInLoc.Year(), dateInLoc.Month(), dateInLoc.Day(), 0, 0, 0, 0, fp.Location)
@@ -107,9 +129,15 @@ func (fp FloatingPeriod) AtDate(date time.Time) Period {
 	}
 
 	if fp.Start >= fp.End {
-		return Period{Start: midnight.Add(fp.Start), End: midnight.AddDate(0, 0, 1).Add(fp.End)}
+		return NewBoundedPeriod(midnight.Add(fp.Start), midnight.AddDate(0, 0, 1).Add(fp.End), fp.Bounds())
 	}
-	return Period{Start: midnight.Add(fp.Start), End: midnight.Add(fp.End)}
+	return NewBoundedPeriod(midnight.Add(fp.Start), midnight.Add(fp.End), fp.Bounds())
+}
+
+// Bounds returns the bounds of the periods produced by the FloatingPeriod, which always include the start time
+// and include the end time if EndInclusive is set.
+func (fp FloatingPeriod) Bounds() Bounds {
+	return boundsOf(true, fp.EndInclusive)
 }
 
 // FromTime returns a period that extends from a given start time to the end of the floating period, or nil
@@ -119,7 +147,7 @@ func (fp FloatingPeriod) FromTime(t time.Time) *Period {
 	if !p.ContainsTime(t, false) {
 		return nil
 	}
-	fromPeriod := NewPeriod(t, p.End)
+	fromPeriod := NewBoundedPeriod(t, p.End, p.Bounds)
 	return &fromPeriod
 }
 
@@ -622,3 +622,11 @@ func TestTwelveHourDisplay(t *testing.T) {
 		})
 	}
 }
+
+func TestFloatingPeriod_Bounds(t *testing.T) {
+	fp := FloatingPeriod{Start: 9 * time.Hour, End: 17 * time.Hour, Days: NewApplicableDaysMonStart(0, 6), Location: time.UTC}
+	assert.Equal(t, ClosedOpen, fp.Bounds())
+	fp.EndInclusive = true
+	assert.Equal(t, ClosedClosed, fp.Bounds())
+	assert.Equal(t, ClosedClosed, fp.AtDate(time.Date(2019, 1, 7, 10, 0, 0, 0, time.UTC)).Bounds)
+}
@@ -0,0 +1,189 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+const (
+	// unboundedStart is displayed in place of an unbounded start time
+	unboundedStart = "-∞"
+	// unboundedEnd is displayed in place of an unbounded end time or an infinite duration
+	unboundedEnd = "∞"
+)
+
+// weekdaysMonStart lists the days of the week in the order they are displayed, starting on Monday
+var weekdaysMonStart = [DaysInWeek]time.Weekday{
+	time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday,
+}
+
+// String returns the Period in interval notation, eg "[2019-01-01T09:00:00Z, 2019-01-01T11:00:00Z)", where the
+// brackets show the Bounds of the period and an unbounded start or end is shown as "-∞" or "∞".
+func (p Period) String() string {
+	bounds := p.Bounds.String()
+	if p.Bounds > OpenClosed {
+		bounds = ClosedOpen.String()
+	}
+	return fmt.Sprintf("%c%s, %s%c", bounds[0], formatTime(p.Start, unboundedStart), formatTime(p.End, unboundedEnd), bounds[1])
+}
+
+// Format implements fmt.Formatter. The %v and %s verbs format the Period as returned by String, and the %+v verb adds
+// the duration of the period, eg "[2019-01-01T09:00:00Z, 2019-01-01T11:00:00Z) (2h0m0s)". The %#v verb formats the
+// Period as Go syntax, as it would be without this method.
+func (p Period) Format(f fmt.State, verb rune) {
+	type period Period
+	formatValue(f, verb, "periodic.Period", period(p), p.String, func() string {
+		duration := unboundedEnd
+		if !p.Start.IsZero() && !p.End.IsZero() {
+			duration = p.Duration().String()
+		}
+		return fmt.Sprintf("%s (%s)", p, duration)
+	})
+}
+
+// LogValue implements slog.LogValuer, logging the Period as returned by String.
+func (p Period) LogValue() slog.Value {
+	return slog.StringValue(p.String())
+}
+
+// String returns the ContinuousPeriod with abbreviated days of the week, eg "Mon 09:00–Fri 17:00 America/Chicago".
+func (cp ContinuousPeriod) String() string {
+	return cp.format(false)
+}
+
+// Format implements fmt.Formatter. The %v and %s verbs format the ContinuousPeriod as returned by String, and the
+// %+v verb uses full names for the days of the week and includes seconds, eg
+// "Monday 09:00:00–Friday 17:00:00 America/Chicago". The %#v verb formats the ContinuousPeriod as Go syntax.
+func (cp ContinuousPeriod) Format(f fmt.State, verb rune) {
+	type continuousPeriod ContinuousPeriod
+	formatValue(f, verb, "periodic.ContinuousPeriod", continuousPeriod(cp), cp.String,
+		func() string { return cp.format(true) })
+}
+
+// LogValue implements slog.LogValuer, logging the ContinuousPeriod as returned by String.
+func (cp ContinuousPeriod) LogValue() slog.Value {
+	return slog.StringValue(cp.String())
+}
+
+// format returns the short or long form of the ContinuousPeriod.
+func (cp ContinuousPeriod) format(long bool) string {
+	return fmt.Sprintf("%s–%s %s", cp.StartWeekTime().format(long), cp.EndWeekTime().format(long), cp.Location)
+}
+
+// String returns the FloatingPeriod with abbreviated days of the week, eg "Mon, Wed, Fri 09:00–17:00 America/Chicago".
+func (fp FloatingPeriod) String() string {
+	return fp.format(false)
+}
+
+// Format implements fmt.Formatter. The %v and %s verbs format the FloatingPeriod as returned by String, and the %+v
+// verb uses full names for the days of the week, includes seconds and notes whether the end time is included, eg
+// "Monday, Wednesday, Friday 09:00:00–17:00:00 America/Chicago (end inclusive)". The %#v verb formats the
+// FloatingPeriod as Go syntax.
+func (fp FloatingPeriod) Format(f fmt.State, verb rune) {
+	type floatingPeriod FloatingPeriod
+	formatValue(f, verb, "periodic.FloatingPeriod", floatingPeriod(fp), fp.String,
+		func() string { return fp.format(true) })
+}
+
+// LogValue implements slog.LogValuer, logging the FloatingPeriod as returned by String.
+func (fp FloatingPeriod) LogValue() slog.Value {
+	return slog.StringValue(fp.String())
+}
+
+// format returns the short or long form of the FloatingPeriod.
+func (fp FloatingPeriod) format(long bool) string {
+	s := fmt.Sprintf(
+		"%s %s–%s %s", fp.Days.format(long), fp.StartTimeOfDay().format(long), fp.EndTimeOfDay().format(long), fp.Location)
+	if long {
+		if fp.EndInclusive {
+			return s + " (end inclusive)"
+		}
+		return s + " (end exclusive)"
+	}
+	return s
+}
+
+// String returns the abbreviated names of the applicable days starting on Monday, eg "Mon, Wed, Fri", or "none" if
+// no days are applicable.
+func (ad ApplicableDays) String() string {
+	return ad.format(false)
+}
+
+// Format implements fmt.Formatter. The %v and %s verbs format the ApplicableDays as returned by String, and the %+v
+// verb uses the full names of the days, eg "Monday, Wednesday, Friday". The %#v verb formats the ApplicableDays as
+// Go syntax.
+func (ad ApplicableDays) Format(f fmt.State, verb rune) {
+	type applicableDays ApplicableDays
+	formatValue(f, verb, "periodic.ApplicableDays", applicableDays(ad), ad.String,
+		func() string { return ad.format(true) })
+}
+
+// LogValue implements slog.LogValuer, logging the ApplicableDays as returned by String.
+func (ad ApplicableDays) LogValue() slog.Value {
+	return slog.StringValue(ad.String())
+}
+
+// format returns the short or long form of the ApplicableDays.
+func (ad ApplicableDays) format(long bool) string {
+	days := make([]string, 0, DaysInWeek)
+	for _, d := range weekdaysMonStart {
+		if ad.DayApplicable(d) {
+			days = append(days, formatWeekday(d, long))
+		}
+	}
+	if len(days) == 0 {
+		return "none"
+	}
+	return strings.Join(days, ", ")
+}
+
+// formatValue implements fmt.Formatter for the types in this package. The %v and %s verbs write the short form,
+// %+v writes the long form and %q writes the quoted short form. Flags and widths are applied as they are for
+// strings. %#v writes the Go syntax of fields, which is the value converted to a type without methods so that it is
+// formatted as it would be by default, under the name of the original type. Other verbs are reported as bad verbs in
+// the same way as the fmt package.
+func formatValue(f fmt.State, verb rune, typeName string, fields any, short, long func() string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		goSyntax := fmt.Sprintf("%#v", fields)
+		fmt.Fprint(f, strings.Replace(goSyntax, fmt.Sprintf("%T", fields), typeName, 1))
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), long())
+	case verb == 'v' || verb == 's' || verb == 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), short())
+	default:
+		fmt.Fprintf(f, "%%!%c(%s=%s)", verb, typeName, short())
+	}
+}
+
+// formatTime formats the start or end time of a period in RFC 3339 format, or returns unbounded if it is zero.
+func formatTime(t time.Time, unbounded string) string {
+	if t.IsZero() {
+		return unbounded
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// formatWeekday returns the full name of the day of the week, or its three letter abbreviation if long is false.
+func formatWeekday(d time.Weekday, long bool) string {
+	if long {
+		return d.String()
+	}
+	return d.String()[:3]
+}
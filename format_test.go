@@ -0,0 +1,143 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriod_Format(t *testing.T) {
+	bounded := NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name     string
+		format   string
+		expected string
+		p        Period
+	}{
+		{
+			name:     "String",
+			format:   "%v",
+			p:        bounded,
+			expected: "[2019-01-01T09:00:00Z, 2019-01-01T11:00:00Z)",
+		}, {
+			name:     "bounds and unbounded ends",
+			format:   "%s",
+			p:        NewBoundedPeriod(time.Time{}, time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC), OpenClosed),
+			expected: "(-∞, 2019-01-01T11:00:00Z]",
+		}, {
+			name:     "long form includes the duration",
+			format:   "%+v",
+			p:        bounded,
+			expected: "[2019-01-01T09:00:00Z, 2019-01-01T11:00:00Z) (2h0m0s)",
+		}, {
+			name:     "long form of an unbounded period",
+			format:   "%+v",
+			p:        NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}),
+			expected: "[2019-01-01T09:00:00Z, ∞) (∞)",
+		}, {
+			name:   "go syntax",
+			format: "%#v",
+			p:      NewBoundedPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}, OpenClosed),
+			expected: "periodic.Period{Start:time.Date(2019, time.January, 1, 9, 0, 0, 0, time.UTC), " +
+				"End:time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), Bounds:0x3}",
+		}, {
+			name:     "quoted",
+			format:   "%q",
+			p:        Period{},
+			expected: `"[-∞, ∞)"`,
+		}, {
+			name:     "width",
+			format:   "%-10s|",
+			p:        Period{},
+			expected: "[-∞, ∞)   |",
+		}, {
+			name:     "bad verb",
+			format:   "%d",
+			p:        Period{},
+			expected: "%!d(periodic.Period=[-∞, ∞))",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, fmt.Sprintf(test.format, test.p))
+		})
+	}
+}
+
+func TestContinuousPeriod_Format(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	cp := NewContinuousPeriod(9*time.Hour, 17*time.Hour+30*time.Second, time.Monday, time.Friday, chiTz)
+	assert.Equal(t, "Mon 09:00–Fri 17:00 America/Chicago", cp.String())
+	assert.Equal(t, "Mon 09:00–Fri 17:00 America/Chicago", fmt.Sprintf("%v", cp))
+	assert.Equal(t, "Monday 09:00:00–Friday 17:00:30 America/Chicago", fmt.Sprintf("%+v", cp))
+}
+
+func TestFloatingPeriod_Format(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	days := ApplicableDays{Monday: true, Wednesday: true, Sunday: true}
+	fp, err := NewFloatingPeriod(9*time.Hour, 17*time.Hour+500*time.Millisecond, days, chiTz, true)
+	require.NoError(t, err)
+	assert.Equal(t, "Mon, Wed, Sun 09:00–17:00 America/Chicago", fp.String())
+	assert.Equal(
+		t, "Monday, Wednesday, Sunday 09:00:00–17:00:00.5 America/Chicago (end inclusive)", fmt.Sprintf("%+v", fp))
+	fp.EndInclusive = false
+	assert.Equal(
+		t, "Monday, Wednesday, Sunday 09:00:00–17:00:00.5 America/Chicago (end exclusive)", fmt.Sprintf("%+v", fp))
+	assert.Equal(t,
+		"periodic.ApplicableDays{Monday:true, Tuesday:false, Wednesday:false, Thursday:false, Friday:false, "+
+			"Saturday:false, Sunday:false}",
+		fmt.Sprintf("%#v", ApplicableDays{Monday: true}))
+}
+
+func TestApplicableDays_Format(t *testing.T) {
+	days := ApplicableDays{Tuesday: true, Saturday: true}
+	assert.Equal(t, "Tue, Sat", days.String())
+	assert.Equal(t, "Tuesday, Saturday", fmt.Sprintf("%+v", days))
+	assert.Equal(t, "none", ApplicableDays{}.String())
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info(
+		"test",
+		"period", NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}),
+		"continuous", NewContinuousPeriod(9*time.Hour, 17*time.Hour, time.Monday, time.Friday, time.UTC),
+		"floating", FloatingPeriod{Start: 9 * time.Hour, End: 17 * time.Hour, Days: ApplicableDays{Monday: true}},
+		"days", ApplicableDays{Friday: true},
+	)
+	assert.Equal(
+		t,
+		`level=INFO msg=test period="[2019-01-01T09:00:00Z, ∞)" continuous="Mon 09:00–Fri 17:00 UTC" `+
+			`floating="Mon 09:00–17:00 UTC" days=Fri`+"\n",
+		buf.String(),
+	)
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"cmp"
+	"time"
+)
+
+// Interval is a range of values of any ordered type, such as parking space numbers, price tiers or Dates, with the
+// same Bounds semantics as Period. Because the zero value of T is often a meaningful value, an Interval that is
+// unbounded in either direction is marked with StartUnbounded or EndUnbounded instead of a zero Start or End.
+//
+// Intervals of ordered types such as integers are compared with IntervalContains and IntervalsIntersect. Intervals of
+// other types, such as time.Time and Date, are compared with ContainsFunc and IntersectsFunc and an ordering of T
+// supplied as a compare function, eg time.Time.Compare, or with the methods of an IntervalCollection, which use the
+// ordering of the collection.
+type Interval[T any] struct {
+	Start  T
+	End    T
+	Bounds Bounds
+	// StartUnbounded is set if the interval has no start, in which case Start is ignored
+	StartUnbounded bool
+	// EndUnbounded is set if the interval has no end, in which case End is ignored
+	EndUnbounded bool
+}
+
+// NewInterval constructs a new Interval from start to end that includes its start but not its end.
+func NewInterval[T any](start, end T) Interval[T] {
+	return Interval[T]{Start: start, End: end}
+}
+
+// NewBoundedInterval constructs a new Interval from start to end with the given bounds.
+func NewBoundedInterval[T any](start, end T, bounds Bounds) Interval[T] {
+	return Interval[T]{Start: start, End: end, Bounds: bounds}
+}
+
+// IntervalContains returns whether the value is in the interval.
+func IntervalContains[T cmp.Ordered](i Interval[T], v T) bool {
+	return i.ContainsFunc(v, cmp.Compare[T])
+}
+
+// IntervalsIntersect returns whether two intervals share any values. As with Period, whether intervals that share
+// only an end point intersect depends on the Bounds of both.
+func IntervalsIntersect[T cmp.Ordered](a, b Interval[T]) bool {
+	return a.IntersectsFunc(b, cmp.Compare[T])
+}
+
+// ContainsFunc returns whether the value is in the Interval, using compare to order values of T. compare must
+// return a negative number if a is before b, a positive number if a is after b and 0 if they are equal.
+func (i Interval[T]) ContainsFunc(v T, compare func(a, b T) int) bool {
+	afterStart := i.StartUnbounded
+	if !afterStart {
+		c := compare(i.Start, v)
+		afterStart = c < 0 || (c == 0 && i.Bounds.StartInclusive())
+	}
+	beforeEnd := i.EndUnbounded
+	if !beforeEnd {
+		c := compare(i.End, v)
+		beforeEnd = c > 0 || (c == 0 && i.Bounds.EndInclusive())
+	}
+	return afterStart && beforeEnd
+}
+
+// IntersectsFunc returns whether the Interval and the other interval share any values, using compare to order values
+// of T in the same way as ContainsFunc. As with Period, whether intervals that share only an end point intersect
+// depends on the Bounds of both.
+func (i Interval[T]) IntersectsFunc(other Interval[T], compare func(a, b T) int) bool {
+	startInterval, endInterval := i, i
+	if compareIntervalStarts(other, i, compare) > 0 {
+		startInterval = other
+	}
+	if compareIntervalEnds(other, i, compare) < 0 {
+		endInterval = other
+	}
+	return intervalStartsBeforeEnd(startInterval, endInterval, compare)
+}
+
+// Interval returns the Period as an Interval of times, with an unbounded start or end wherever the Period's start
+// or end is zero.
+func (p Period) Interval() Interval[time.Time] {
+	return Interval[time.Time]{
+		Start:          p.Start,
+		End:            p.End,
+		Bounds:         p.Bounds,
+		StartUnbounded: p.Start.IsZero(),
+		EndUnbounded:   p.End.IsZero(),
+	}
+}
+
+// NewPeriodFromInterval constructs a new Period from an Interval of times, using a zero start or end time wherever
+// the interval is unbounded.
+func NewPeriodFromInterval(i Interval[time.Time]) Period {
+	p := NewBoundedPeriod(i.Start, i.End, i.Bounds)
+	if i.StartUnbounded {
+		p.Start = time.Time{}
+	}
+	if i.EndUnbounded {
+		p.End = time.Time{}
+	}
+	return p
+}
+
+// compareIntervalStarts compares where two intervals begin, returning -1 if a begins before b, 1 if a begins after b
+// and 0 if they begin at the same point. An inclusive start begins before an exclusive start at the same value.
+func compareIntervalStarts[T any](a, b Interval[T], compare func(a, b T) int) int {
+	switch {
+	case a.StartUnbounded && b.StartUnbounded:
+		return 0
+	case a.StartUnbounded:
+		return -1
+	case b.StartUnbounded:
+		return 1
+	}
+	if c := compare(a.Start, b.Start); c != 0 {
+		return c
+	}
+	return compareInclusive(b.Bounds.StartInclusive(), a.Bounds.StartInclusive())
+}
+
+// compareIntervalEnds compares where two intervals end, returning -1 if a ends before b, 1 if a ends after b and 0 if
+// they end at the same point. An inclusive end ends after an exclusive end at the same value.
+func compareIntervalEnds[T any](a, b Interval[T], compare func(a, b T) int) int {
+	switch {
+	case a.EndUnbounded && b.EndUnbounded:
+		return 0
+	case a.EndUnbounded:
+		return 1
+	case b.EndUnbounded:
+		return -1
+	}
+	if c := compare(a.End, b.End); c != 0 {
+		return c
+	}
+	return compareInclusive(a.Bounds.EndInclusive(), b.Bounds.EndInclusive())
+}
+
+// intervalStartsBeforeEnd returns whether the start of interval s comes before the end of interval e such that at
+// least one value is after the start of s and before the end of e.
+func intervalStartsBeforeEnd[T any](s, e Interval[T], compare func(a, b T) int) bool {
+	if s.StartUnbounded || e.EndUnbounded {
+		return true
+	}
+	c := compare(s.Start, e.End)
+	return c < 0 || (c == 0 && s.Bounds.StartInclusive() && e.Bounds.EndInclusive())
+}
@@ -0,0 +1,622 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"cmp"
+	"fmt"
+	"sync"
+)
+
+// IntervalCollection is a data structure for storing intervals of any ordered type, such as ranges of parking space
+// numbers or Dates, and arbitrary data objects associated with each interval. Once populated, IntervalCollection
+// allows callers to quickly identify subsets of the collection that intersect with another interval or find
+// intervals that contain a given value. PeriodCollection is an IntervalCollection of times.
+//
+// IntervalCollection is implemented on top of a self-balancing red-black tree.
+// This means that insertion and deletion operations take logarithmic time while querying can never exceed linear
+// time. But on average, as long as the query interval is not large relative to the total range stored, querying
+// should perform in better than linear time.
+type IntervalCollection[T any, K comparable, V any] struct {
+	// compare orders values of T
+	compare func(a, b T) int
+	root    *node[T, K, V]
+	// nodes is an external mapping of a node's key to a pointer of the node since the interval tree is keyed on
+	// the node's interval start
+	nodes map[K]*node[T, K, V]
+	mutex sync.RWMutex
+}
+
+type rotationDirection int
+
+const (
+	right rotationDirection = iota
+	left
+)
+
+// TraversalOrder is the type of depth-first search to use when traversing the backing tree of an
+// IntervalCollection or PeriodCollection
+type TraversalOrder int
+
+const (
+	// PreOrder corresponds to a pre-order depth-first traversal (i.e. root, left, right)
+	PreOrder = iota
+	// InOrder corresponds to an in-order depth-first traversal (i.e. left, root, right)
+	InOrder
+	// PostOrder corresponds to a post-order depth-first traversal (i.e. left, right, root)
+	PostOrder
+)
+
+// NewIntervalCollection constructs a new IntervalCollection for intervals of an ordered type such as an integer.
+func NewIntervalCollection[T cmp.Ordered, K comparable, V any]() *IntervalCollection[T, K, V] {
+	return NewIntervalCollectionFunc[T, K, V](cmp.Compare[T])
+}
+
+// NewIntervalCollectionFunc constructs a new IntervalCollection for intervals of any type, which are ordered by the
+// compare function. compare must return a negative number if a is before b, a positive number if a is after b and 0
+// if they are equal, eg time.Time.Compare or Date.Compare.
+func NewIntervalCollectionFunc[T any, K comparable, V any](compare func(a, b T) int) *IntervalCollection[T, K, V] {
+	return &IntervalCollection[T, K, V]{
+		nodes:   make(map[K]*node[T, K, V]),
+		root:    &node[T, K, V]{leaf: true},
+		compare: compare,
+	}
+}
+
+// Insert adds a new interval into the collection. The key parameter is a unique identifier that must be supplied
+// when inserting a new interval. contents is an arbitrary object associated with the interval inserted. If an
+// interval already exists with the given key, an error will be returned.
+func (ic *IntervalCollection[T, K, V]) Insert(key K, interval Interval[T], contents V) error {
+	ic.mutex.Lock()
+	defer ic.mutex.Unlock()
+	if _, ok := ic.nodes[key]; ok {
+		return fmt.Errorf("interval with key %v already exists", key)
+	}
+	ic.insert(key, interval, contents)
+	return nil
+}
+
+// insert is the internal function that adds a new red node to the tree. Note this function does not lock the mutex,
+// that must be done by the caller.
+func (ic *IntervalCollection[T, K, V]) insert(key K, interval Interval[T], contents V) {
+	inserted := newNode[T, K, V](interval, key, contents, red)
+	ic.nodes[key] = inserted
+	if ic.root == nil || ic.root.leaf {
+		inserted.color = black
+		ic.root = inserted
+	} else {
+		ic.insertRecursive(ic.root, inserted)
+		ic.insertRepair(inserted)
+	}
+}
+
+// insertRecursive recursively adds new red node containing an interval and ID into the tree. The inserted node is
+// stored in the inserted parameter.
+func (ic *IntervalCollection[T, K, V]) insertRecursive(root, inserted *node[T, K, V]) {
+	// augment the tree with the maximum end of its subtree
+	if inserted.interval.EndUnbounded {
+		var unbounded T
+		root.maxEnd, root.maxEndUnbounded = unbounded, true
+	} else if !root.maxEndUnbounded && ic.compare(inserted.interval.End, root.maxEnd) > 0 {
+		root.maxEnd = inserted.interval.End
+	}
+
+	if root.intervalToLeft(inserted.interval, ic.compare) {
+		if root.left.leaf {
+			inserted.parent = root
+			root.left = inserted
+			return
+		}
+		ic.insertRecursive(root.left, inserted)
+
+	} else {
+		if root.right.leaf {
+			inserted.parent = root
+			root.right = inserted
+			return
+		}
+		ic.insertRecursive(root.right, inserted)
+	}
+}
+
+// insertRepair rebalances the tree to maintain the red-black property after an insertion
+func (ic *IntervalCollection[T, K, V]) insertRepair(n *node[T, K, V]) {
+	if n == ic.root {
+		// n is the actual root of the tree, by definition it is always black
+		n.color = black
+		return
+	}
+
+	if n.parent.color == black {
+		// the parent is already black so nothing has to be done
+		return
+	}
+
+	uncle := n.parent.sibling()
+	uncleColor := uncle.nodeColor()
+
+	if uncleColor == red {
+		// the parent is red; if it has a red sibling, change parent & uncle to black and change grandparent to red
+		uncle.color = black
+		n.parent.color = black
+		if n.parent.parent != nil {
+			n.parent.parent.color = red
+			ic.insertRepair(n.parent.parent)
+			return
+		}
+	}
+
+	if uncleColor == black && n.parent.color == red {
+		// move n so that it is on the same side of its parent as its parent is to its grandparent (i.e. it is on the
+		// outside of the subtree)
+		isInsideRight := n.parent.isLeftChild() && !n.isLeftChild()
+		isInsideLeft := !n.parent.isLeftChild() && n.isLeftChild()
+		if isInsideRight {
+			ic.rotate(n.parent, left)
+			n = n.left
+		} else if isInsideLeft {
+			ic.rotate(n.parent, right)
+			n = n.right
+		}
+
+		// rotate again to move n into the grandparent's spot
+		n.parent.color = black
+		if n.parent.parent != nil {
+			n.parent.parent.color = red
+			if n.isLeftChild() {
+				ic.rotate(n.parent.parent, right)
+			} else {
+				ic.rotate(n.parent.parent, left)
+			}
+		}
+	}
+}
+
+// rotate rotates a node in the tree about node n either left or right.
+func (ic *IntervalCollection[T, K, V]) rotate(n *node[T, K, V], direction rotationDirection) {
+	// y is the node that is going to take the place of n in the tree
+	var y *node[T, K, V]
+	switch direction {
+	case right:
+		y = n.left
+	case left:
+		y = n.right
+	}
+
+	// move y into n's position
+	if n == ic.root {
+		ic.root = y
+	} else {
+		if n.isLeftChild() {
+			n.parent.left = y
+		} else {
+			n.parent.right = y
+		}
+	}
+	y.parent = n.parent
+
+	// rotate about n
+	switch direction {
+	case right:
+		n.left = y.right
+		y.right.parent = n
+		y.right = n
+	case left:
+		n.right = y.left
+		y.left.parent = n
+		y.left = n
+	}
+	n.parent = y
+	ic.updateMaxEnd(n)
+	if !y.leaf {
+		ic.updateMaxEnd(y)
+	}
+}
+
+// updateMaxEnd recalculates the maximum end of the node's subtree from the node and its children.
+func (ic *IntervalCollection[T, K, V]) updateMaxEnd(n *node[T, K, V]) {
+	n.maxEnd, n.maxEndUnbounded = n.maxEndOfSubtree(ic.compare)
+}
+
+// Delete removes the interval and its associated contents with the provided key. If no interval with the provided
+// key exists, this function is a no-op.
+func (ic *IntervalCollection[T, K, V]) Delete(key K) {
+	ic.mutex.Lock()
+	defer ic.mutex.Unlock()
+	ic.delete(key)
+}
+
+// delete is the internal method that determines if a deletion is necessary, and if so, executes the deletion
+func (ic *IntervalCollection[T, K, V]) delete(key K) {
+	node, ok := ic.nodes[key]
+	if !ok {
+		return
+	}
+	ic.deleteNode(node)
+}
+
+// deleteNode removes the specified node from the tree
+func (ic *IntervalCollection[T, K, V]) deleteNode(n *node[T, K, V]) {
+	// y is the node that is going to be deleted, z is the node that will be moved into y's place
+	var y *node[T, K, V]
+	var z *node[T, K, V]
+
+	delete(ic.nodes, n.key)
+	if n.left.leaf || n.right.leaf {
+		// n has 0 or 1 children so it can be deleted
+		y = n
+	} else {
+		// n is an internal node, delete its successor and swap the contents of its successor into n
+		y = n.successor()
+		ic.nodes[y.key] = n
+	}
+	if !y.left.leaf {
+		z = y.left
+	} else if !y.right.leaf {
+		z = y.right
+	} else {
+		z = &node[T, K, V]{leaf: true}
+	}
+	z.parent = y.parent
+
+	if y.parent != nil {
+		if y.isLeftChild() {
+			y.parent.left = z
+		} else {
+			y.parent.right = z
+		}
+	} else {
+		ic.root = z
+	}
+	n.interval, n.key, n.contents = y.interval, y.key, y.contents
+
+	// update maxEnd all the way up the tree
+	parent := z.parent
+	for parent != nil {
+		ic.updateMaxEnd(parent)
+		parent = parent.parent
+	}
+	ic.updateMaxEnd(n)
+
+	if y.color == black {
+		ic.deleteRepair(z)
+	}
+}
+
+// deleteRepair rebalances the tree to maintain the red-black property after a deletion
+func (ic *IntervalCollection[T, K, V]) deleteRepair(n *node[T, K, V]) {
+	if n == ic.root || n.color == red {
+		n.color = black
+		return
+	}
+	ic.deleteRepairCase1(n)
+	if ic.deleteRepairCase2(n) {
+		ic.deleteRepair(n.parent)
+		return
+	}
+	ic.deleteRepairCase3(n)
+	ic.deleteRepairCase4(n)
+}
+
+// deleteRepairCase1 handles the case of the deleted node's sibling being red. changes the parent's color to red and
+// the sibling's color to black and rotates to make the sibling the parent.
+func (ic *IntervalCollection[T, K, V]) deleteRepairCase1(n *node[T, K, V]) {
+	sibling := n.sibling()
+	if sibling.nodeColor() == red {
+		sibling.color = black
+		n.parent.color = red
+		if n.isLeftChild() {
+			ic.rotate(n.parent, left)
+		} else {
+			ic.rotate(n.parent, right)
+		}
+	}
+}
+
+// deleteRepairCase2 handles the case of the deleted node's sibling being a leaf or the sibling and its children
+// colored black. It handles this case by changing the sibling to red and returns whether the parent needs
+// to be repaired.
+func (ic *IntervalCollection[T, K, V]) deleteRepairCase2(n *node[T, K, V]) bool {
+	sibling := n.sibling()
+	if sibling.leaf {
+		return true
+	}
+	numChildren := 0
+	if !sibling.left.leaf {
+		numChildren++
+	}
+	if !sibling.right.leaf {
+		numChildren++
+	}
+	if sibling.color == black && numChildren == 2 && sibling.left.color == black && sibling.right.color == black {
+		sibling.color = red
+		return true
+	}
+	return false
+}
+
+// deleteRepairCase3 handles the case of the node's sibling colored black with a red child on the right if the deleted
+// node is on the right, or a red child on the left if the deleted node is on the left. It handles this case by
+// recoloring the sibling red and recoloring the appropriate child to black then rotating to move the sibling up.
+func (ic *IntervalCollection[T, K, V]) deleteRepairCase3(n *node[T, K, V]) {
+	sibling := n.sibling()
+	if !sibling.leaf && sibling.nodeColor() == black {
+		if sibling.right.nodeColor() == red && !n.isLeftChild() {
+			sibling.color = red
+			sibling.right.color = black
+			ic.rotate(sibling, left)
+		} else if sibling.left.nodeColor() == red && n.isLeftChild() {
+			sibling.color = red
+			sibling.left.color = black
+			ic.rotate(sibling, right)
+		}
+	}
+}
+
+// deleteRepairCase4 handles the case of the sibling of n colored black with a red child on the right if the deleted
+// node is on the left, or the sibling having a red child on the left if the deleted node is on the right. It recolors
+// the appropriate child of the sibling, makes the sibling the same color as the parent, makes the parent black, and
+// rotates to move the sibling up.
+func (ic *IntervalCollection[T, K, V]) deleteRepairCase4(n *node[T, K, V]) {
+	sibling := n.sibling()
+	if !sibling.leaf && sibling.nodeColor() == black {
+		if sibling.left.nodeColor() == red && !n.isLeftChild() {
+			sibling.left.color = black
+			sibling.color = n.parent.color
+			n.parent.color = black
+			ic.rotate(n.parent, right)
+		} else if sibling.right.nodeColor() == red && n.isLeftChild() {
+			sibling.right.color = black
+			sibling.color = n.parent.color
+			n.parent.color = black
+			ic.rotate(n.parent, left)
+		}
+	}
+}
+
+// Update the interval and associated contents with the given key. If no interval with the given key exists,
+// a new interval is inserted.
+func (ic *IntervalCollection[T, K, V]) Update(key K, newInterval Interval[T], newContents V) {
+	ic.mutex.Lock()
+	defer ic.mutex.Unlock()
+	ic.update(key, newInterval, newContents)
+}
+
+// update is the internal function that performs the update on the tree.
+func (ic *IntervalCollection[T, K, V]) update(key K, newInterval Interval[T], newContents V) {
+	oldNode, ok := ic.nodes[key]
+	if !ok {
+		ic.insert(key, newInterval, newContents)
+		return
+	}
+	if ic.equal(oldNode.interval, newInterval) {
+		// if the interval hasn't changed, just swap the contents
+		oldNode.contents = newContents
+		return
+	}
+	// if the interval has changed, delete the old node and insert a new one
+	ic.deleteNode(oldNode)
+	ic.insert(key, newInterval, newContents)
+}
+
+// IntervalContains returns whether the value is in the interval, using the ordering of the collection.
+func (ic *IntervalCollection[T, K, V]) IntervalContains(i Interval[T], v T) bool {
+	return i.ContainsFunc(v, ic.compare)
+}
+
+// IntervalsIntersect returns whether two intervals share any values, using the ordering of the collection.
+func (ic *IntervalCollection[T, K, V]) IntervalsIntersect(a, b Interval[T]) bool {
+	return a.IntersectsFunc(b, ic.compare)
+}
+
+// AnyContains returns whether there is any stored interval that contains the supplied value.
+func (ic *IntervalCollection[T, K, V]) AnyContains(v T) bool {
+	ic.mutex.RLock()
+	defer ic.mutex.RUnlock()
+	return ic.anyContains(ic.root, v)
+}
+
+// anyContains is the internal function that recursively searches the tree for the supplied value.
+func (ic *IntervalCollection[T, K, V]) anyContains(root *node[T, K, V], v T) bool {
+	if root.leaf {
+		return false
+	}
+	if root.interval.ContainsFunc(v, ic.compare) {
+		return true
+	}
+	if !root.left.leaf && ic.endsAtOrAfter(root.left, v) {
+		return ic.anyContains(root.left, v)
+	}
+	return ic.anyContains(root.right, v)
+}
+
+// Containing will find and return the contents of all objects in the collection whose intervals contain the given
+// value.
+func (ic *IntervalCollection[T, K, V]) Containing(v T) []V {
+	ic.mutex.RLock()
+	defer ic.mutex.RUnlock()
+	results := make([]V, 0)
+	if ic.root.leaf {
+		return results
+	}
+	ic.containing(v, ic.root, &results)
+	return results
+}
+
+// containing is the recursive step of Containing that will determine which branches should be traversed in the
+// collection and append the contents of all nodes that contain the queried value.
+// This method traverses the tree in-order, meaning that the results returned are sorted by start ascending.
+func (ic *IntervalCollection[T, K, V]) containing(v T, root *node[T, K, V], results *[]V) {
+	if !root.left.leaf && ic.endsAtOrAfter(root.left, v) {
+		ic.containing(v, root.left, results)
+	}
+	if root.interval.ContainsFunc(v, ic.compare) {
+		*results = append(*results, root.contents)
+	}
+	// The current node (root) has the earliest start of any node in the right subtree.
+	// If the interval from root's start to root.right.maxEnd does not contain the queried value, it is guaranteed
+	// that there are no nodes in the right subtree that contain the value so the traversal can be skipped.
+	if !root.right.leaf && ic.rightSubtreeSpan(root).ContainsFunc(v, ic.compare) {
+		ic.containing(v, root.right, results)
+	}
+}
+
+// Intersecting returns the contents of all objects whose associated intervals intersect the supplied query interval.
+// Whether intervals that share only an end point intersect depends on the bounds of both intervals. The results
+// returned by Intersecting are sorted in ascending order by the associated interval's start.
+func (ic *IntervalCollection[T, K, V]) Intersecting(query Interval[T]) []V {
+	ic.mutex.RLock()
+	defer ic.mutex.RUnlock()
+	results := make([]V, 0)
+	if ic.root.leaf {
+		return results
+	}
+	ic.intersecting(query, ic.root, func(n *node[T, K, V]) {
+		results = append(results, n.contents)
+	})
+	return results
+}
+
+// intersecting is the recursive step of Intersecting that will determine which branches should be traversed in the
+// collection and visit all nodes that intersect the queried interval.
+// This method traverses the tree in-order, meaning that nodes are visited in ascending order of start.
+func (ic *IntervalCollection[T, K, V]) intersecting(query Interval[T], root *node[T, K, V], visit func(n *node[T, K, V])) {
+	if !root.left.leaf && (query.StartUnbounded || ic.endsAtOrAfter(root.left, query.Start)) {
+		ic.intersecting(query, root.left, visit)
+	}
+	if root.interval.IntersectsFunc(query, ic.compare) {
+		visit(root)
+	}
+	// The current node (root) has the earliest start of any node in the right subtree.
+	// If the interval from root's start to root.right.maxEnd does not intersect the queried interval, it is
+	// guaranteed that there are no nodes in the right subtree that intersect the interval so the traversal can be
+	// skipped.
+	if !root.right.leaf && ic.rightSubtreeSpan(root).IntersectsFunc(query, ic.compare) {
+		ic.intersecting(query, root.right, visit)
+	}
+}
+
+// AnyIntersecting returns whether or not there are any intervals in the collection that intersect the query
+// interval. Compared to Intersecting, this method is more efficient because it will terminate early once an
+// intersection is found.
+func (ic *IntervalCollection[T, K, V]) AnyIntersecting(query Interval[T]) bool {
+	ic.mutex.RLock()
+	defer ic.mutex.RUnlock()
+	if ic.root.leaf {
+		return false
+	}
+	return ic.anyIntersecting(query, ic.root)
+}
+
+// anyIntersecting is the internal function that recursively searches the tree and returns whether or not it has
+// found an intersection.
+func (ic *IntervalCollection[T, K, V]) anyIntersecting(query Interval[T], root *node[T, K, V]) bool {
+	if root.interval.IntersectsFunc(query, ic.compare) {
+		return true
+	}
+	if !root.left.leaf && (query.StartUnbounded || ic.endsAtOrAfter(root.left, query.Start)) {
+		return ic.anyIntersecting(query, root.left)
+	}
+	if !root.right.leaf && (query.StartUnbounded || ic.endsAtOrAfter(root.right, query.Start)) {
+		return ic.anyIntersecting(query, root.right)
+	}
+	return false
+}
+
+// ContainsKey returns whether or not an interval with a corresponding key exists.
+func (ic *IntervalCollection[T, K, V]) ContainsKey(key K) bool {
+	ic.mutex.RLock()
+	defer ic.mutex.RUnlock()
+	_, ok := ic.nodes[key]
+	return ok
+}
+
+// DepthFirstTraverse traverses the collection's backing tree depth-first and returns the contents of every
+// node in the tree by the ordering given.
+func (ic *IntervalCollection[T, K, V]) DepthFirstTraverse(order TraversalOrder) []V {
+	ic.mutex.RLock()
+	defer ic.mutex.RUnlock()
+	nodeContents := make([]V, 0, len(ic.nodes))
+	ic.depthFirstTraverse(ic.root, order, &nodeContents)
+	return nodeContents
+}
+
+// depthFirstTraverse is the internal recursive function for traversing the interval tree.
+func (ic *IntervalCollection[T, K, V]) depthFirstTraverse(n *node[T, K, V], order TraversalOrder, visitedContents *[]V) {
+	if n.leaf {
+		return
+	}
+	if order == PreOrder {
+		*visitedContents = append(*visitedContents, n.contents)
+	}
+	ic.depthFirstTraverse(n.left, order, visitedContents)
+	if order == InOrder {
+		*visitedContents = append(*visitedContents, n.contents)
+	}
+	ic.depthFirstTraverse(n.right, order, visitedContents)
+	if order == PostOrder {
+		*visitedContents = append(*visitedContents, n.contents)
+	}
+}
+
+// ContentsOfKey returns the contents stored at the provided key in the collection. This method
+// runs in O(1) time and can be used if the key is known but not the interval.
+func (ic *IntervalCollection[T, K, V]) ContentsOfKey(key K) (V, error) {
+	ic.mutex.RLock()
+	defer ic.mutex.RUnlock()
+	node, ok := ic.nodes[key]
+	if !ok {
+		var result V
+		return result, fmt.Errorf("key %v does not exist", key)
+	}
+	return node.contents, nil
+}
+
+// DeleteOnCondition will delete all nodes in the collection with contents that satisfy the given condition
+// Note that this method can be time consuming for large trees and multiple deletions as it may involve multiple
+// tree rotations.
+func (ic *IntervalCollection[T, K, V]) DeleteOnCondition(condition func(contents V) bool) {
+	ic.mutex.Lock()
+	defer ic.mutex.Unlock()
+	for _, node := range ic.nodes {
+		if condition(node.contents) {
+			ic.deleteNode(node)
+		}
+	}
+}
+
+// endsAtOrAfter returns whether any interval in the subtree of n ends at or after the value.
+func (ic *IntervalCollection[T, K, V]) endsAtOrAfter(n *node[T, K, V], v T) bool {
+	return n.maxEndUnbounded || ic.compare(n.maxEnd, v) >= 0
+}
+
+// rightSubtreeSpan returns the closed interval from the start of root to the maximum end of its right subtree, which
+// contains every interval in the right subtree since root has the earliest start of any of them. The bounds are
+// closed since intervals in the subtree may include their start and end.
+func (ic *IntervalCollection[T, K, V]) rightSubtreeSpan(root *node[T, K, V]) Interval[T] {
+	return Interval[T]{
+		Start:          root.interval.Start,
+		End:            root.right.maxEnd,
+		Bounds:         ClosedClosed,
+		StartUnbounded: root.interval.StartUnbounded,
+		EndUnbounded:   root.right.maxEndUnbounded,
+	}
+}
+
+// equal returns whether two intervals have the same start, end and bounds.
+func (ic *IntervalCollection[T, K, V]) equal(a, b Interval[T]) bool {
+	return a.Bounds == b.Bounds &&
+		compareIntervalStarts(a, b, ic.compare) == 0 && compareIntervalEnds(a, b, ic.compare) == 0
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntervalCollection_Int(t *testing.T) {
+	// parking spaces numbered 0 and up, grouped into levels
+	ic := NewIntervalCollection[int, string, string]()
+	require.NoError(t, ic.Insert("ground", NewInterval(0, 100), "ground"))
+	require.NoError(t, ic.Insert("first", NewInterval(100, 200), "first"))
+	require.NoError(t, ic.Insert("roof", Interval[int]{Start: 200, EndUnbounded: true}, "roof"))
+	require.NoError(t, ic.Insert("reserved", NewBoundedInterval(90, 110, ClosedClosed), "reserved"))
+	assert.Error(t, ic.Insert("ground", NewInterval(0, 10), "duplicate"))
+
+	tests := []struct {
+		name     string
+		expected []string
+		v        int
+	}{
+		{"zero is contained in the first interval", []string{"ground"}, 0},
+		{"overlapping intervals", []string{"reserved", "first"}, 100},
+		{"unbounded end", []string{"roof"}, 1000},
+		{"negative values are not contained", []string{}, -1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ic.Containing(test.v))
+			assert.Equal(t, len(test.expected) > 0, ic.AnyContains(test.v))
+		})
+	}
+
+	assert.Equal(t, []string{"ground", "reserved"}, ic.Intersecting(NewInterval(50, 95)))
+	assert.True(t, ic.AnyIntersecting(Interval[int]{End: 0, StartUnbounded: true, Bounds: ClosedClosed}))
+	assert.False(t, ic.AnyIntersecting(Interval[int]{End: 0, StartUnbounded: true}))
+
+	ic.Update("reserved", NewInterval(300, 310), "reserved")
+	assert.Equal(t, []string{"first"}, ic.Containing(100))
+	assert.Equal(t, []string{"roof", "reserved"}, ic.Containing(305))
+
+	ic.Delete("roof")
+	assert.False(t, ic.ContainsKey("roof"))
+	assert.Equal(t, []string{"reserved"}, ic.Containing(305))
+	assert.Equal(t, []string{"ground", "first", "reserved"}, ic.DepthFirstTraverse(InOrder))
+
+	ic.DeleteOnCondition(func(contents string) bool { return contents != "first" })
+	contents, err := ic.ContentsOfKey("first")
+	require.NoError(t, err)
+	assert.Equal(t, "first", contents)
+	_, err = ic.ContentsOfKey("ground")
+	assert.Error(t, err)
+}
+
+func TestIntervalCollection_Date(t *testing.T) {
+	ic := NewIntervalCollectionFunc[Date, int, string](Date.Compare)
+	require.NoError(t, ic.Insert(0, NewBoundedInterval(NewDate(2023, 1, 1), NewDate(2023, 3, 31), ClosedClosed), "Q1"))
+	require.NoError(t, ic.Insert(1, NewBoundedInterval(NewDate(2023, 4, 1), NewDate(2023, 6, 30), ClosedClosed), "Q2"))
+	require.NoError(t, ic.Insert(2, Interval[Date]{End: NewDate(2023, 1, 1), StartUnbounded: true}, "before"))
+	assert.Equal(t, []string{"Q1"}, ic.Containing(NewDate(2023, 3, 31)))
+	assert.Equal(t, []string{"before"}, ic.Containing(Date{}))
+	assert.Equal(t, []string{"Q1", "Q2"}, ic.Intersecting(NewInterval(NewDate(2023, 3, 1), NewDate(2023, 5, 1))))
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"cmp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntervalContains(t *testing.T) {
+	tests := []struct {
+		name     string
+		i        Interval[int]
+		v        int
+		expected bool
+	}{
+		{"value within the interval", NewInterval(1, 10), 5, true},
+		{"inclusive start", NewInterval(1, 10), 1, true},
+		{"exclusive end", NewInterval(1, 10), 10, false},
+		{"inclusive end", NewBoundedInterval(1, 10, ClosedClosed), 10, true},
+		{"exclusive start", NewBoundedInterval(1, 10, OpenOpen), 1, false},
+		{"zero is a bounded start", NewInterval(0, 10), -1, false},
+		{"unbounded start", Interval[int]{End: 10, StartUnbounded: true}, -100, true},
+		{"unbounded end", Interval[int]{Start: 1, EndUnbounded: true}, 100, true},
+		{"value before the interval", NewInterval(1, 10), -5, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, IntervalContains(test.i, test.v))
+			assert.Equal(t, test.expected, test.i.ContainsFunc(test.v, cmp.Compare[int]))
+		})
+	}
+}
+
+func TestIntervalsIntersect(t *testing.T) {
+	tests := []struct {
+		name     string
+		i        Interval[int]
+		other    Interval[int]
+		expected bool
+	}{
+		{"overlapping intervals", NewInterval(1, 10), NewInterval(5, 15), true},
+		{"disjoint intervals", NewInterval(1, 10), NewInterval(11, 15), false},
+		{"adjacent exclusive end", NewInterval(1, 10), NewInterval(10, 15), false},
+		{"adjacent inclusive ends", NewBoundedInterval(1, 10, ClosedClosed), NewInterval(10, 15), true},
+		{"unbounded intervals", Interval[int]{End: 1, StartUnbounded: true}, Interval[int]{Start: 0, EndUnbounded: true}, true},
+		{"unbounded start before the interval", Interval[int]{End: 1, StartUnbounded: true}, NewInterval(5, 15), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, IntervalsIntersect(test.i, test.other))
+			assert.Equal(t, test.expected, IntervalsIntersect(test.other, test.i))
+			assert.Equal(t, test.expected, test.i.IntersectsFunc(test.other, cmp.Compare[int]))
+		})
+	}
+}
+
+func TestInterval_Dates(t *testing.T) {
+	i := NewBoundedInterval(NewDate(2023, 1, 1), NewDate(2023, 1, 31), ClosedClosed)
+	assert.True(t, i.ContainsFunc(NewDate(2023, 1, 31), Date.Compare))
+	assert.False(t, i.ContainsFunc(NewDate(2023, 2, 1), Date.Compare))
+	assert.True(t, i.IntersectsFunc(NewInterval(NewDate(2023, 1, 31), NewDate(2023, 2, 28)), Date.Compare))
+	ic := NewIntervalCollectionFunc[Date, int, any](Date.Compare)
+	assert.True(t, ic.IntervalContains(i, NewDate(2023, 1, 31)))
+	assert.False(t, ic.IntervalsIntersect(i, NewInterval(NewDate(2023, 2, 1), NewDate(2023, 2, 28))))
+}
+
+func TestPeriod_Interval(t *testing.T) {
+	start, end := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		p        Period
+		expected Interval[time.Time]
+	}{
+		{
+			name:     "bounded period",
+			p:        NewBoundedPeriod(start, end, OpenClosed),
+			expected: NewBoundedInterval(start, end, OpenClosed),
+		}, {
+			name:     "unbounded start",
+			p:        Period{End: end},
+			expected: Interval[time.Time]{End: end, StartUnbounded: true},
+		}, {
+			name:     "unbounded end",
+			p:        Period{Start: start},
+			expected: Interval[time.Time]{Start: start, EndUnbounded: true},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			i := test.p.Interval()
+			assert.Equal(t, test.expected, i)
+			assert.Equal(t, test.p, NewPeriodFromInterval(i))
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DSTPolicy determines how a LocalDateTime is resolved when its wall clock time is skipped or repeated by a daylight
+// saving time transition in the location it is resolved against.
+type DSTPolicy int
+
+const (
+	// DSTEarlier resolves a repeated wall clock time to its first occurrence, and a skipped wall clock time to the
+	// time that is the length of the transition earlier, eg 02:30 on the day clocks spring forward from 02:00 to
+	// 03:00 resolves to 01:30.
+	DSTEarlier DSTPolicy = iota
+	// DSTLater resolves a repeated wall clock time to its second occurrence, and a skipped wall clock time to the time
+	// that is the length of the transition later, eg 02:30 on the day clocks spring forward from 02:00 to 03:00
+	// resolves to 03:30.
+	DSTLater
+	// DSTShiftForward resolves a repeated wall clock time to its first occurrence, and a skipped wall clock time to
+	// the end of the transition, eg 02:30 on the day clocks spring forward from 02:00 to 03:00 resolves to 03:00.
+	DSTShiftForward
+	// DSTError returns a LocalTimeResolutionError if the wall clock time is skipped or repeated.
+	DSTError
+)
+
+// LocalTimeResolutionError is the error type returned if a LocalDateTime cannot be resolved in a location
+type LocalTimeResolutionError string
+
+// Error implements the error interface for LocalTimeResolutionError
+func (l LocalTimeResolutionError) Error() string {
+	return string(l)
+}
+
+// LocalDateTime is a civil date and wall clock time, such as 2026-11-01 01:30, that is not tied to a location. Use
+// Resolve to find the time at which it occurs in a particular location. The zero LocalDateTime is used as an
+// unbounded start or end of a LocalPeriod.
+type LocalDateTime struct {
+	Date      Date
+	TimeOfDay TimeOfDay
+}
+
+// NewLocalDateTime constructs a new LocalDateTime.
+func NewLocalDateTime(d Date, tod TimeOfDay) LocalDateTime {
+	return LocalDateTime{Date: d, TimeOfDay: tod}
+}
+
+// LocalDateTimeOf returns the date and wall clock time of t in its location.
+func LocalDateTimeOf(t time.Time) LocalDateTime {
+	return LocalDateTime{Date: DateOf(t), TimeOfDay: TimeOfDayOf(t)}
+}
+
+// ParseLocalDateTime parses a LocalDateTime in the format "2006-01-02T15:04", where the time may be any time of day
+// accepted by ParseTimeOfDay, and the date and time may also be separated by a space.
+func ParseLocalDateTime(s string) (LocalDateTime, error) {
+	date, clock, ok := strings.Cut(s, "T")
+	if !ok {
+		date, clock, ok = strings.Cut(s, " ")
+	}
+	if !ok {
+		return LocalDateTime{}, fmt.Errorf("invalid local date time %q", s)
+	}
+	d, err := ParseDate(date)
+	if err != nil {
+		return LocalDateTime{}, fmt.Errorf("invalid local date time %q: %w", s, err)
+	}
+	tod, err := ParseTimeOfDay(clock)
+	if err != nil {
+		return LocalDateTime{}, fmt.Errorf("invalid local date time %q: %w", s, err)
+	}
+	return LocalDateTime{Date: d, TimeOfDay: tod}, nil
+}
+
+// String returns the LocalDateTime in the format "2006-01-02T15:04", including seconds and fractional seconds only
+// if they are not zero.
+func (ldt LocalDateTime) String() string {
+	return ldt.Date.String() + "T" + ldt.TimeOfDay.String()
+}
+
+// IsZero returns whether the LocalDateTime is the zero value.
+func (ldt LocalDateTime) IsZero() bool {
+	return ldt == LocalDateTime{}
+}
+
+// Compare returns -1 if the LocalDateTime is before other, 1 if it is after other and 0 if they are the same. 24:00 on
+// one date is the same as 00:00 on the next.
+func (ldt LocalDateTime) Compare(other LocalDateTime) int {
+	return ldt.wall().Compare(other.wall())
+}
+
+// MarshalText implements encoding.TextMarshaler, formatting the LocalDateTime as returned by String.
+func (ldt LocalDateTime) MarshalText() ([]byte, error) {
+	if !ldt.TimeOfDay.Valid() {
+		return nil, fmt.Errorf("invalid time of day %v", ldt.TimeOfDay.Duration())
+	}
+	return []byte(ldt.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting any LocalDateTime that can be parsed by
+// ParseLocalDateTime.
+func (ldt *LocalDateTime) UnmarshalText(text []byte) error {
+	parsed, err := ParseLocalDateTime(string(text))
+	if err != nil {
+		return err
+	}
+	*ldt = parsed
+	return nil
+}
+
+// Resolve returns the time at which the wall clock in the given location shows the LocalDateTime. If the wall clock
+// time is skipped or repeated by a daylight saving time transition, the policy determines the result. If loc is nil,
+// UTC is used.
+func (ldt LocalDateTime) Resolve(loc *time.Location, policy DSTPolicy) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	wall := ldt.wall()
+	// the offsets in effect a day either side of the wall clock time are the only ones that can apply to it, assuming
+	// that a location does not have two transitions within a day
+	_, offsetBefore := wall.Add(-dayLength).In(loc).Zone()
+	_, offsetAfter := wall.Add(dayLength).In(loc).Zone()
+	first := wall.Add(-time.Duration(offsetBefore) * time.Second).In(loc)
+	second := wall.Add(-time.Duration(offsetAfter) * time.Second).In(loc)
+	if second.Before(first) {
+		first, second = second, first
+	}
+	firstValid := wallClock(first, loc).Equal(wall)
+	secondValid := wallClock(second, loc).Equal(wall)
+	switch {
+	case firstValid && secondValid && !first.Equal(second):
+		// the wall clock time is repeated
+		switch policy {
+		case DSTEarlier, DSTShiftForward:
+			return first, nil
+		case DSTLater:
+			return second, nil
+		}
+		return time.Time{}, LocalTimeResolutionError(
+			fmt.Sprintf("local time %s occurs twice in %s", ldt, loc))
+	case firstValid:
+		return first, nil
+	case secondValid:
+		return second, nil
+	}
+	// the wall clock time is skipped, so first and second are the times either side of the transition that are
+	// the length of the transition from the wall clock time
+	switch policy {
+	case DSTEarlier:
+		return first, nil
+	case DSTLater:
+		return second, nil
+	case DSTShiftForward:
+		_, end := first.ZoneBounds()
+		return end, nil
+	}
+	return time.Time{}, LocalTimeResolutionError(fmt.Sprintf("local time %s does not occur in %s", ldt, loc))
+}
+
+// wall returns the LocalDateTime as a time in UTC with the same wall clock time, in the same form as wallClock.
+func (ldt LocalDateTime) wall() time.Time {
+	return ldt.Date.midnight().Add(ldt.TimeOfDay.Duration())
+}
+
+// LocalPeriod is a period of civil date times that is not tied to a location, such as 2026-11-01 01:30 to 03:00,
+// which is resolved to a Period in a particular location with Resolve. As with Period, a zero Start or End means that
+// the period is unbounded in that direction.
+type LocalPeriod struct {
+	Start  LocalDateTime
+	End    LocalDateTime
+	Bounds Bounds
+}
+
+// NewLocalPeriod constructs a new LocalPeriod from start to end. The period includes its start but not its end.
+func NewLocalPeriod(start, end LocalDateTime) LocalPeriod {
+	return LocalPeriod{Start: start, End: end}
+}
+
+// LocalPeriodOf returns the LocalPeriod with the wall clock start and end times of the period in the given location.
+// Unbounded ends remain unbounded. If loc is nil, UTC is used.
+func LocalPeriodOf(p Period, loc *time.Location) LocalPeriod {
+	if loc == nil {
+		loc = time.UTC
+	}
+	lp := LocalPeriod{Bounds: p.Bounds}
+	if !p.Start.IsZero() {
+		lp.Start = LocalDateTimeOf(p.Start.In(loc))
+	}
+	if !p.End.IsZero() {
+		lp.End = LocalDateTimeOf(p.End.In(loc))
+	}
+	return lp
+}
+
+// String returns the LocalPeriod in interval notation, eg "[2026-11-01T01:30, 2026-11-01T03:00)", where an unbounded
+// start or end is shown as "-∞" or "∞".
+func (lp LocalPeriod) String() string {
+	bounds := lp.Bounds.String()
+	if lp.Bounds > OpenClosed {
+		bounds = ClosedOpen.String()
+	}
+	start, end := unboundedStart, unboundedEnd
+	if !lp.Start.IsZero() {
+		start = lp.Start.String()
+	}
+	if !lp.End.IsZero() {
+		end = lp.End.String()
+	}
+	return fmt.Sprintf("%c%s, %s%c", bounds[0], start, end, bounds[1])
+}
+
+// Resolve returns the Period in the given location that the LocalPeriod describes, resolving the start and end with
+// the given policy, and keeping the Bounds of the LocalPeriod. An error is returned if either end cannot be resolved
+// or if the resolved period is not valid, which can happen if the period lies within a daylight saving time
+// transition. If loc is nil, UTC is used.
+func (lp LocalPeriod) Resolve(loc *time.Location, policy DSTPolicy) (Period, error) {
+	p := Period{Bounds: lp.Bounds}
+	var err error
+	if !lp.Start.IsZero() {
+		if p.Start, err = lp.Start.Resolve(loc, policy); err != nil {
+			return Period{}, err
+		}
+	}
+	if !lp.End.IsZero() {
+		if p.End, err = lp.End.Resolve(loc, policy); err != nil {
+			return Period{}, err
+		}
+	}
+	if err = p.Validate(); err != nil {
+		return Period{}, err
+	}
+	return p, nil
+}
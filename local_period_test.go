@@ -0,0 +1,236 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocalDateTime(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    LocalDateTime
+		expectError bool
+	}{
+		{
+			name:     "date and time",
+			input:    "2026-11-01T01:30",
+			expected: NewLocalDateTime(NewDate(2026, 11, 1), TimeOfDay(90*time.Minute)),
+		}, {
+			name:     "separated by a space with seconds",
+			input:    "2026-11-01 01:30:15",
+			expected: NewLocalDateTime(NewDate(2026, 11, 1), TimeOfDay(90*time.Minute+15*time.Second)),
+		},
+		{name: "no time", input: "2026-11-01", expectError: true},
+		{name: "invalid date", input: "2026-02-30T01:30", expectError: true},
+		{name: "invalid time", input: "2026-11-01T25:00", expectError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ParseLocalDateTime(test.input)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestLocalDateTime_Resolve(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	// clocks in Chicago spring forward from 02:00 to 03:00 on 2019-03-10 and fall back from 02:00 to 01:00 on
+	// 2019-11-03
+	skipped := NewLocalDateTime(NewDate(2019, 3, 10), TimeOfDay(2*time.Hour+30*time.Minute))
+	repeated := NewLocalDateTime(NewDate(2019, 11, 3), TimeOfDay(time.Hour+30*time.Minute))
+	tests := []struct {
+		expected    time.Time
+		loc         *time.Location
+		name        string
+		ldt         LocalDateTime
+		policy      DSTPolicy
+		expectError bool
+	}{
+		{
+			name:     "ordinary time",
+			ldt:      NewLocalDateTime(NewDate(2019, 3, 10), TimeOfDay(4*time.Hour)),
+			loc:      chiTz,
+			policy:   DSTError,
+			expected: time.Date(2019, 3, 10, 9, 0, 0, 0, time.UTC),
+		}, {
+			name:     "nil location is UTC",
+			ldt:      skipped,
+			policy:   DSTError,
+			expected: time.Date(2019, 3, 10, 2, 30, 0, 0, time.UTC),
+		}, {
+			name:     "skipped time resolves earlier",
+			ldt:      skipped,
+			loc:      chiTz,
+			policy:   DSTEarlier,
+			expected: time.Date(2019, 3, 10, 7, 30, 0, 0, time.UTC),
+		}, {
+			name:     "skipped time resolves later",
+			ldt:      skipped,
+			loc:      chiTz,
+			policy:   DSTLater,
+			expected: time.Date(2019, 3, 10, 8, 30, 0, 0, time.UTC),
+		}, {
+			name:     "skipped time shifts forward to the end of the transition",
+			ldt:      skipped,
+			loc:      chiTz,
+			policy:   DSTShiftForward,
+			expected: time.Date(2019, 3, 10, 8, 0, 0, 0, time.UTC),
+		}, {
+			name:        "skipped time is an error",
+			ldt:         skipped,
+			loc:         chiTz,
+			policy:      DSTError,
+			expectError: true,
+		}, {
+			name:     "repeated time resolves to the first occurrence",
+			ldt:      repeated,
+			loc:      chiTz,
+			policy:   DSTEarlier,
+			expected: time.Date(2019, 11, 3, 6, 30, 0, 0, time.UTC),
+		}, {
+			name:     "repeated time resolves to the second occurrence",
+			ldt:      repeated,
+			loc:      chiTz,
+			policy:   DSTLater,
+			expected: time.Date(2019, 11, 3, 7, 30, 0, 0, time.UTC),
+		}, {
+			name:     "repeated time is not shifted",
+			ldt:      repeated,
+			loc:      chiTz,
+			policy:   DSTShiftForward,
+			expected: time.Date(2019, 11, 3, 6, 30, 0, 0, time.UTC),
+		}, {
+			name:        "repeated time is an error",
+			ldt:         repeated,
+			loc:         chiTz,
+			policy:      DSTError,
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var result time.Time
+			result, err = test.ldt.Resolve(test.loc, test.policy)
+			if test.expectError {
+				assert.IsType(t, LocalTimeResolutionError(""), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, test.expected.Equal(result), "expected %v, got %v", test.expected, result)
+			if test.loc != nil {
+				assert.Equal(t, test.loc, result.Location())
+			}
+		})
+	}
+}
+
+func TestLocalPeriod_Resolve(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	start := NewLocalDateTime(NewDate(2019, 11, 3), TimeOfDay(time.Hour+30*time.Minute))
+	end := NewLocalDateTime(NewDate(2019, 11, 3), TimeOfDay(3*time.Hour))
+	tests := []struct {
+		expected    Period
+		name        string
+		lp          LocalPeriod
+		policy      DSTPolicy
+		expectError bool
+	}{
+		{
+			name:     "repeated start resolves to the first occurrence",
+			lp:       NewLocalPeriod(start, end),
+			policy:   DSTEarlier,
+			expected: NewPeriod(time.Date(2019, 11, 3, 6, 30, 0, 0, time.UTC), time.Date(2019, 11, 3, 9, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "repeated start resolves to the second occurrence",
+			lp:       NewLocalPeriod(start, end),
+			policy:   DSTLater,
+			expected: NewPeriod(time.Date(2019, 11, 3, 7, 30, 0, 0, time.UTC), time.Date(2019, 11, 3, 9, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "repeated start is an error",
+			lp:          NewLocalPeriod(start, end),
+			policy:      DSTError,
+			expectError: true,
+		}, {
+			name:     "unbounded ends and bounds are kept",
+			lp:       LocalPeriod{End: end, Bounds: OpenClosed},
+			policy:   DSTError,
+			expected: Period{End: time.Date(2019, 11, 3, 9, 0, 0, 0, time.UTC), Bounds: OpenClosed},
+		}, {
+			name: "period within a skipped hour shifts forward to an empty period",
+			lp: NewLocalPeriod(
+				NewLocalDateTime(NewDate(2019, 3, 10), TimeOfDay(2*time.Hour+15*time.Minute)),
+				NewLocalDateTime(NewDate(2019, 3, 10), TimeOfDay(2*time.Hour+45*time.Minute))),
+			policy:   DSTShiftForward,
+			expected: NewPeriod(time.Date(2019, 3, 10, 8, 0, 0, 0, time.UTC), time.Date(2019, 3, 10, 8, 0, 0, 0, time.UTC)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var result Period
+			result, err = test.lp.Resolve(chiTz, test.policy)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+			assert.Equal(t, test.expected.Bounds, result.Bounds)
+		})
+	}
+}
+
+func TestLocalPeriodOf(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	p := Period{Start: time.Date(2019, 11, 3, 6, 30, 0, 0, time.UTC), Bounds: ClosedClosed}
+	lp := LocalPeriodOf(p, chiTz)
+	assert.Equal(t, LocalPeriod{
+		Start:  NewLocalDateTime(NewDate(2019, 11, 3), TimeOfDay(time.Hour+30*time.Minute)),
+		Bounds: ClosedClosed,
+	}, lp)
+	assert.Equal(t, "[2019-11-03T01:30, ∞]", lp.String())
+	resolved, err := lp.Resolve(chiTz, DSTEarlier)
+	require.NoError(t, err)
+	assert.True(t, p.Equals(resolved))
+}
+
+func TestLocalPeriod_JSON(t *testing.T) {
+	lp := NewLocalPeriod(
+		NewLocalDateTime(NewDate(2026, 11, 1), TimeOfDay(90*time.Minute)),
+		NewLocalDateTime(NewDate(2026, 11, 1), TimeOfDay(3*time.Hour)))
+	marshaled, err := json.Marshal(lp)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Start": "2026-11-01T01:30", "End": "2026-11-01T03:00", "Bounds": "[)"}`, string(marshaled))
+	var unmarshaled LocalPeriod
+	require.NoError(t, json.Unmarshal(marshaled, &unmarshaled))
+	assert.Equal(t, lp, unmarshaled)
+	assert.Equal(t, -1, lp.Start.Compare(lp.End))
+	assert.Equal(t, 0, NewLocalDateTime(NewDate(2026, 10, 31), EndOfDay).Compare(
+		NewLocalDateTime(NewDate(2026, 11, 1), Midnight)))
+}
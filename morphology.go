@@ -0,0 +1,63 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"time"
+)
+
+// DilatePeriods grows every period by d on each side and merges the results with MergePeriods, which closes any gaps
+// between periods of up to twice d. For example, dilating reservations by a 10 minute turnover buffer gives the times
+// at which a space is either reserved or being turned over. A negative d erodes the periods instead. Unbounded ends
+// remain unbounded.
+func DilatePeriods(periods []Period, d time.Duration) []Period {
+	if d < 0 {
+		return ErodePeriods(periods, -d)
+	}
+	dilated := make([]Period, 0, len(periods))
+	for _, p := range periods {
+		dilated = append(dilated, p.ExtendStart(d).ExtendEnd(d))
+	}
+	return MergePeriods(dilated)
+}
+
+// ErodePeriods merges the periods with MergePeriods and shrinks every merged period by d on each side. Merged periods
+// that are left empty, such as those no longer than twice d, are removed. A negative d dilates the periods instead.
+// Unbounded ends remain unbounded.
+func ErodePeriods(periods []Period, d time.Duration) []Period {
+	if d < 0 {
+		return DilatePeriods(periods, -d)
+	}
+	merged := MergePeriods(periods)
+	eroded := merged[:0]
+	for _, p := range merged {
+		if p = p.ExtendStart(-d).ExtendEnd(-d); !p.empty() {
+			eroded = append(eroded, p)
+		}
+	}
+	return eroded
+}
+
+// OpenPeriods erodes and then dilates the periods by d, which removes brief blips no longer than twice d while leaving
+// longer merged periods unchanged.
+func OpenPeriods(periods []Period, d time.Duration) []Period {
+	return DilatePeriods(ErodePeriods(periods, d), d)
+}
+
+// ClosePeriods dilates and then erodes the periods by d, which fills gaps between periods of up to twice d while
+// leaving the outer ends of the merged periods unchanged.
+func ClosePeriods(periods []Period, d time.Duration) []Period {
+	return ErodePeriods(DilatePeriods(periods, d), d)
+}
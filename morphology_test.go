@@ -0,0 +1,131 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDilatePeriods(t *testing.T) {
+	tests := []struct {
+		name     string
+		periods  []Period
+		expected []Period
+		d        time.Duration
+	}{
+		{
+			name: "periods grow on each side",
+			periods: []Period{
+				NewPeriod(time.Unix(100, 0), time.Unix(200, 0)),
+				NewPeriod(time.Unix(300, 0), time.Unix(400, 0)),
+			},
+			d: 10 * time.Second,
+			expected: []Period{
+				NewPeriod(time.Unix(90, 0), time.Unix(210, 0)),
+				NewPeriod(time.Unix(290, 0), time.Unix(410, 0)),
+			},
+		}, {
+			name: "gaps up to twice the duration are closed",
+			periods: []Period{
+				NewPeriod(time.Unix(100, 0), time.Unix(200, 0)),
+				NewPeriod(time.Unix(220, 0), time.Unix(300, 0)),
+			},
+			d:        10 * time.Second,
+			expected: []Period{NewPeriod(time.Unix(90, 0), time.Unix(310, 0))},
+		}, {
+			name:     "unbounded ends remain unbounded",
+			periods:  []Period{NewPeriod(time.Time{}, time.Unix(200, 0)), NewPeriod(time.Unix(300, 0), time.Time{})},
+			d:        10 * time.Second,
+			expected: []Period{NewPeriod(time.Time{}, time.Unix(210, 0)), NewPeriod(time.Unix(290, 0), time.Time{})},
+		}, {
+			name:     "negative duration erodes",
+			periods:  []Period{NewPeriod(time.Unix(100, 0), time.Unix(200, 0))},
+			d:        -10 * time.Second,
+			expected: []Period{NewPeriod(time.Unix(110, 0), time.Unix(190, 0))},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, DilatePeriods(test.periods, test.d))
+		})
+	}
+}
+
+func TestErodePeriods(t *testing.T) {
+	tests := []struct {
+		name     string
+		periods  []Period
+		expected []Period
+		d        time.Duration
+	}{
+		{
+			name: "merged periods shrink on each side",
+			periods: []Period{
+				NewPeriod(time.Unix(100, 0), time.Unix(200, 0)),
+				NewPeriod(time.Unix(150, 0), time.Unix(300, 0)),
+			},
+			d:        10 * time.Second,
+			expected: []Period{NewPeriod(time.Unix(110, 0), time.Unix(290, 0))},
+		}, {
+			name: "periods no longer than twice the duration are removed",
+			periods: []Period{
+				NewPeriod(time.Unix(100, 0), time.Unix(115, 0)),
+				NewPeriod(time.Unix(200, 0), time.Unix(220, 0)),
+				NewPeriod(time.Unix(300, 0), time.Unix(400, 0)),
+			},
+			d:        10 * time.Second,
+			expected: []Period{NewPeriod(time.Unix(310, 0), time.Unix(390, 0))},
+		}, {
+			name:     "unbounded ends remain unbounded",
+			periods:  []Period{NewPeriod(time.Time{}, time.Unix(200, 0)), NewPeriod(time.Unix(300, 0), time.Time{})},
+			d:        10 * time.Second,
+			expected: []Period{NewPeriod(time.Time{}, time.Unix(190, 0)), NewPeriod(time.Unix(310, 0), time.Time{})},
+		}, {
+			name:     "no periods",
+			d:        10 * time.Second,
+			expected: []Period{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ErodePeriods(test.periods, test.d))
+		})
+	}
+}
+
+func TestOpenPeriods(t *testing.T) {
+	periods := []Period{
+		NewPeriod(time.Unix(100, 0), time.Unix(105, 0)),
+		NewPeriod(time.Unix(200, 0), time.Unix(300, 0)),
+		NewPeriod(time.Unix(300, 0), time.Unix(400, 0)),
+	}
+	assert.Equal(t, []Period{NewPeriod(time.Unix(200, 0), time.Unix(400, 0))}, OpenPeriods(periods, 10*time.Second))
+}
+
+func TestClosePeriods(t *testing.T) {
+	periods := []Period{
+		NewPeriod(time.Unix(100, 0), time.Unix(200, 0)),
+		NewPeriod(time.Unix(215, 0), time.Unix(300, 0)),
+		NewPeriod(time.Unix(400, 0), time.Unix(500, 0)),
+	}
+	assert.Equal(t, []Period{
+		NewPeriod(time.Unix(100, 0), time.Unix(300, 0)),
+		NewPeriod(time.Unix(400, 0), time.Unix(500, 0)),
+	}, ClosePeriods(periods, 10*time.Second))
+	assert.Equal(t, MergePeriods(periods), ClosePeriods(periods, 0))
+}
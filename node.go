@@ -14,10 +14,6 @@
 
 package periodic
 
-import (
-	"time"
-)
-
 type color int
 
 const (
@@ -25,38 +21,44 @@ const (
 	red
 )
 
-// node is the private interval tree node type that contains the node's subtree, the maximum end time of the node's
-// subtree, and its color. Instead of storing leaves as nil, leaves are stored as sentinel nodes with the leaf property
-// set to make deletions easier.
-type node[K comparable, V any] struct {
-	period   Period
-	maxEnd   time.Time
+// node is the private interval tree node type that contains the node's subtree, the maximum end of the intervals in
+// the node's subtree, and its color. Instead of storing leaves as nil, leaves are stored as sentinel nodes with the
+// leaf property set to make deletions easier.
+type node[T any, K comparable, V any] struct {
+	left     *node[T, K, V]
+	right    *node[T, K, V]
+	parent   *node[T, K, V]
+	maxEnd   T
 	key      K
 	contents V
-	left     *node[K, V]
-	right    *node[K, V]
-	parent   *node[K, V]
+	interval Interval[T]
 	color    color
 	leaf     bool
+	// maxEndUnbounded is set if any interval in the node's subtree is unbounded on the end, in which case maxEnd is
+	// the zero value
+	maxEndUnbounded bool
 }
 
 // newNode creates a new node with data and a color, making sure to construct its left and right children as
 // sentinel nil nodes.
-func newNode[K comparable, V any](period Period, key K, contents V, color color) *node[K, V] {
-	n := &node[K, V]{
-		period:   period,
-		key:      key,
-		contents: contents,
-		color:    color,
-		maxEnd:   period.End,
+func newNode[T any, K comparable, V any](interval Interval[T], key K, contents V, color color) *node[T, K, V] {
+	n := &node[T, K, V]{
+		interval:        interval,
+		key:             key,
+		contents:        contents,
+		color:           color,
+		maxEndUnbounded: interval.EndUnbounded,
 	}
-	l, r := &node[K, V]{leaf: true, parent: n}, &node[K, V]{leaf: true, parent: n}
+	if !interval.EndUnbounded {
+		n.maxEnd = interval.End
+	}
+	l, r := &node[T, K, V]{leaf: true, parent: n}, &node[T, K, V]{leaf: true, parent: n}
 	n.left, n.right = l, r
 	return n
 }
 
 // isLeftChild returns whether a node is the left child of its parent.
-func (n *node[K, V]) isLeftChild() bool {
+func (n *node[T, K, V]) isLeftChild() bool {
 	if n.parent == nil {
 		return false
 	}
@@ -65,7 +67,7 @@ func (n *node[K, V]) isLeftChild() bool {
 
 // sibling returns the node's sibling; i.e. if the node is the parent's left child, the sibling
 // is the parent's right child, and vice versa.
-func (n *node[K, V]) sibling() *node[K, V] {
+func (n *node[T, K, V]) sibling() *node[T, K, V] {
 	if n.parent == nil {
 		return nil
 	}
@@ -76,7 +78,7 @@ func (n *node[K, V]) sibling() *node[K, V] {
 }
 
 // nodeColor returns the color of the node, taking into account that nil nodes are black
-func (n *node[K, V]) nodeColor() color {
+func (n *node[T, K, V]) nodeColor() color {
 	if n.leaf {
 		return black
 	}
@@ -85,7 +87,7 @@ func (n *node[K, V]) nodeColor() color {
 
 // successor returns the next node that would be traversed in an in-order traversal.
 // This is either the the minimum value in node n's right subtree or the first ancestor that is to the left of n.
-func (n *node[K, V]) successor() *node[K, V] {
+func (n *node[T, K, V]) successor() *node[T, K, V] {
 	if !n.right.leaf {
 		successor := n.right
 		for !successor.left.leaf {
@@ -106,33 +108,32 @@ func (n *node[K, V]) successor() *node[K, V] {
 	return parent
 }
 
-// maxEndOfSubtree returns the latest end time of the node and its subtree.
-func (n *node[K, V]) maxEndOfSubtree() time.Time {
-	if n.period.End.IsZero() {
-		return time.Time{}
-	}
-	if n.left.leaf && n.right.leaf {
-		return n.period.End
+// maxEndOfSubtree returns the latest end of the node's interval and the intervals in its subtree, and whether any of
+// them is unbounded on the end, in which case the returned end is the zero value.
+func (n *node[T, K, V]) maxEndOfSubtree(compare func(a, b T) int) (T, bool) {
+	var unbounded T
+	if n.interval.EndUnbounded {
+		return unbounded, true
 	}
-	if n.left.leaf && !n.right.leaf {
-		if n.right.maxEnd.IsZero() {
-			return n.right.maxEnd
+	maxEnd := n.interval.End
+	for _, child := range []*node[T, K, V]{n.left, n.right} {
+		if child.leaf {
+			continue
 		}
-		return MaxTime(n.period.End, n.right.maxEnd)
-	}
-	if !n.left.leaf && n.right.leaf {
-		if n.left.maxEnd.IsZero() {
-			return n.left.maxEnd
+		if child.maxEndUnbounded {
+			return unbounded, true
+		}
+		if compare(child.maxEnd, maxEnd) > 0 {
+			maxEnd = child.maxEnd
 		}
-		return MaxTime(n.period.End, n.left.maxEnd)
-	}
-	if n.right.maxEnd.IsZero() || n.left.maxEnd.IsZero() {
-		return time.Time{}
 	}
-	return MaxTime(n.period.End, n.left.maxEnd, n.right.maxEnd)
+	return maxEnd, false
 }
 
-// periodToLeft decides whether a period belongs to the left of the node.
-func (n *node[K, V]) periodToLeft(p Period) bool {
-	return p.Start.Before(n.period.Start)
+// intervalToLeft decides whether an interval belongs to the left of the node.
+func (n *node[T, K, V]) intervalToLeft(i Interval[T], compare func(a, b T) int) bool {
+	if i.StartUnbounded || n.interval.StartUnbounded {
+		return i.StartUnbounded && !n.interval.StartUnbounded
+	}
+	return compare(i.Start, n.interval.Start) < 0
 }
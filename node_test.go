@@ -22,13 +22,13 @@ import (
 )
 
 func TestNode_isLeftChild(t *testing.T) {
-	root := &node[int, any]{}
-	left := &node[int, any]{parent: root}
-	right := &node[int, any]{parent: root}
+	root := &node[time.Time, int, any]{}
+	left := &node[time.Time, int, any]{parent: root}
+	right := &node[time.Time, int, any]{parent: root}
 	root.left = left
 	root.right = right
 	tests := []struct {
-		testNode *node[int, any]
+		testNode *node[time.Time, int, any]
 		name     string
 		outcome  bool
 	}{
@@ -52,14 +52,14 @@ func TestNode_isLeftChild(t *testing.T) {
 }
 
 func TestNode_sibling(t *testing.T) {
-	root := &node[int, any]{}
-	left := &node[int, any]{parent: root}
-	right := &node[int, any]{parent: root}
+	root := &node[time.Time, int, any]{}
+	left := &node[time.Time, int, any]{parent: root}
+	right := &node[time.Time, int, any]{parent: root}
 	root.left = left
 	root.right = right
 	tests := []struct {
-		testNode *node[int, any]
-		outcome  *node[int, any]
+		testNode *node[time.Time, int, any]
+		outcome  *node[time.Time, int, any]
 		name     string
 	}{
 		{
@@ -84,26 +84,26 @@ func TestNode_sibling(t *testing.T) {
 
 func TestNode_nodeColor(t *testing.T) {
 	tests := []struct {
-		setup func() *node[int, any]
+		setup func() *node[time.Time, int, any]
 		name  string
 		color color
 	}{
 		{
 			name: "black node is black",
-			setup: func() *node[int, any] {
-				return &node[int, any]{color: black}
+			setup: func() *node[time.Time, int, any] {
+				return &node[time.Time, int, any]{color: black}
 			},
 			color: black,
 		}, {
 			name: "red node is red",
-			setup: func() *node[int, any] {
-				return &node[int, any]{color: red}
+			setup: func() *node[time.Time, int, any] {
+				return &node[time.Time, int, any]{color: red}
 			},
 			color: red,
 		}, {
 			name: "leaf node is black",
-			setup: func() *node[int, any] {
-				return &node[int, any]{leaf: true}
+			setup: func() *node[time.Time, int, any] {
+				return &node[time.Time, int, any]{leaf: true}
 			},
 			color: black,
 		},
@@ -123,46 +123,46 @@ func TestNode_maxEndOfSubtree(t *testing.T) {
 		 /     \
 		C       E
 	*/
-	a := newNode[int, any](Period{time.Unix(20, 0), time.Unix(30, 0)}, 0, nil, black)
-	b := newNode[int, any](Period{time.Unix(15, 0), time.Unix(25, 0)}, 0, nil, black)
-	c := newNode[int, any](Period{time.Unix(5, 0), time.Unix(45, 0)}, 0, nil, black)
-	d := newNode[int, any](Period{time.Unix(22, 0), time.Unix(101, 0)}, 0, nil, black)
-	e := newNode[int, any](Period{time.Unix(25, 0), time.Unix(100, 0)}, 0, nil, black)
+	a := newNode[time.Time, int, any](NewPeriod(time.Unix(20, 0), time.Unix(30, 0)).Interval(), 0, nil, black)
+	b := newNode[time.Time, int, any](NewPeriod(time.Unix(15, 0), time.Unix(25, 0)).Interval(), 0, nil, black)
+	c := newNode[time.Time, int, any](NewPeriod(time.Unix(5, 0), time.Unix(45, 0)).Interval(), 0, nil, black)
+	d := newNode[time.Time, int, any](NewPeriod(time.Unix(22, 0), time.Unix(101, 0)).Interval(), 0, nil, black)
+	e := newNode[time.Time, int, any](NewPeriod(time.Unix(25, 0), time.Unix(100, 0)).Interval(), 0, nil, black)
 	a.left, a.right = b, d
 	b.left = c
 	d.right = e
-	a.maxEnd = d.period.End
-	b.maxEnd = c.period.End
-	c.maxEnd = c.period.End
-	d.maxEnd = d.period.End
-	e.maxEnd = e.period.End
+	a.maxEnd = d.interval.End
+	b.maxEnd = c.interval.End
+	c.maxEnd = c.interval.End
+	d.maxEnd = d.interval.End
+	e.maxEnd = e.interval.End
 
-	f := newNode[int, any](Period{time.Unix(20, 0), time.Unix(30, 0)}, 0, nil, black)
-	g := newNode[int, any](Period{time.Unix(15, 0), time.Time{}}, 0, nil, black)
+	f := newNode[time.Time, int, any](NewPeriod(time.Unix(20, 0), time.Unix(30, 0)).Interval(), 0, nil, black)
+	g := newNode[time.Time, int, any](NewPeriod(time.Unix(15, 0), time.Time{}).Interval(), 0, nil, black)
 	f.left = g
-	g.maxEnd = g.period.End
+	g.maxEnd = g.interval.End
 
-	h := newNode[int, any](Period{time.Unix(20, 0), time.Unix(30, 0)}, 0, nil, black)
-	i := newNode[int, any](Period{time.Unix(25, 0), time.Time{}}, 0, nil, black)
+	h := newNode[time.Time, int, any](NewPeriod(time.Unix(20, 0), time.Unix(30, 0)).Interval(), 0, nil, black)
+	i := newNode[time.Time, int, any](NewPeriod(time.Unix(25, 0), time.Time{}).Interval(), 0, nil, black)
 	h.right = i
-	h.maxEnd = h.period.End
+	h.maxEnd = h.interval.End
 
-	j := newNode[int, any](Period{time.Unix(20, 0), time.Unix(30, 0)}, 0, nil, black)
-	k := newNode[int, any](Period{time.Unix(15, 0), time.Unix(25, 0)}, 0, nil, black)
-	l := newNode[int, any](Period{time.Unix(30, 0), time.Time{}}, 0, nil, black)
+	j := newNode[time.Time, int, any](NewPeriod(time.Unix(20, 0), time.Unix(30, 0)).Interval(), 0, nil, black)
+	k := newNode[time.Time, int, any](NewPeriod(time.Unix(15, 0), time.Unix(25, 0)).Interval(), 0, nil, black)
+	l := newNode[time.Time, int, any](NewPeriod(time.Unix(30, 0), time.Time{}).Interval(), 0, nil, black)
 	j.left, j.right = k, l
-	k.maxEnd = k.period.End
-	j.maxEnd = j.period.End
+	k.maxEnd = k.interval.End
+	j.maxEnd = j.interval.End
 
 	tests := []struct {
 		expected time.Time
-		node     *node[int, any]
+		node     *node[time.Time, int, any]
 		name     string
 	}{
 		{
 			name:     "node with only child leafs returns its own max end time",
 			node:     c,
-			expected: c.period.End,
+			expected: c.interval.End,
 		}, {
 			name:     "node with only left child returns max of its period end and its left child's max end",
 			node:     b,
@@ -186,19 +186,21 @@ func TestNode_maxEndOfSubtree(t *testing.T) {
 			node: j,
 		}, {
 			name: "node with zero end time returns zero",
-			node: newNode[int, any](Period{time.Unix(20, 0), time.Time{}}, 0, nil, black),
+			node: newNode[time.Time, int, any](NewPeriod(time.Unix(20, 0), time.Time{}).Interval(), 0, nil, black),
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			assert.Equal(t, test.expected, test.node.maxEndOfSubtree())
+			maxEnd, unbounded := test.node.maxEndOfSubtree(time.Time.Compare)
+			assert.Equal(t, test.expected, maxEnd)
+			assert.Equal(t, test.expected.IsZero(), unbounded)
 		})
 	}
 }
 
-func TestNode_periodToLeft(t *testing.T) {
-	n := &node[int, any]{
-		period: NewPeriod(time.Date(2018, 12, 7, 0, 0, 0, 0, time.UTC), time.Date(2018, 12, 8, 0, 0, 0, 0, time.UTC)),
+func TestNode_intervalToLeft(t *testing.T) {
+	n := &node[time.Time, int, any]{
+		interval: NewPeriod(time.Date(2018, 12, 7, 0, 0, 0, 0, time.UTC), time.Date(2018, 12, 8, 0, 0, 0, 0, time.UTC)).Interval(),
 	}
 	tests := []struct {
 		p       Period
@@ -219,7 +221,7 @@ func TestNode_periodToLeft(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			assert.Equal(t, test.outcome, n.periodToLeft(test.p))
+			assert.Equal(t, test.outcome, n.intervalToLeft(test.p.Interval(), time.Time.Compare))
 		})
 	}
 }
@@ -16,7 +16,6 @@ package periodic
 
 import (
 	"fmt"
-	"sync"
 	"time"
 )
 
@@ -24,40 +23,30 @@ import (
 // period.Once populated, PeriodCollection allows callers to quickly identify subsets of the collection
 // that intersect with another period or find periods that contain a given time.
 //
-// PeriodCollection is implemented on top of a self-balancing red-black tree.
+// PeriodCollection is an IntervalCollection of times, which is implemented on top of a self-balancing red-black tree.
 // This means that insertion and deletion operations take logarithmic time while querying can never exceed linear
 // time. But on average, as long as the query period is not large relative to the total time range stored, querying
 // should perform in better than linear time.
 type PeriodCollection[K comparable, V any] struct {
-	root *node[K, V]
-	// nodes is an external mapping of a node's key to a pointer of the node since the interval tree is keyed on
-	// the node's period start time
-	nodes map[K]*node[K, V]
-	mutex sync.RWMutex
+	intervals *IntervalCollection[time.Time, K, V]
+	// validate is set if the collection refuses to store invalid periods
+	validate bool
 }
 
-type rotationDirection int
-
-const (
-	right rotationDirection = iota
-	left
-)
-
-// TraversalOrder is the type of depth-first search to use when traversing PeriodCollection's backing tree
-type TraversalOrder int
-
-const (
-	// PreOrder corresponds to a pre-order depth-first traversal (i.e. root, left, right)
-	PreOrder = iota
-	// InOrder corresponds to an in-order depth-first traversal (i.e. left, root, right)
-	InOrder
-	// PostOrder corresponds to a post-order depth-first traversal (i.e. left, right, root)
-	PostOrder
-)
-
 // NewPeriodCollection constructs a new PeriodCollection
 func NewPeriodCollection[K comparable, V any]() *PeriodCollection[K, V] {
-	return &PeriodCollection[K, V]{nodes: make(map[K]*node[K, V]), root: &node[K, V]{leaf: true}}
+	return &PeriodCollection[K, V]{intervals: NewIntervalCollectionFunc[time.Time, K, V](time.Time.Compare)}
+}
+
+// NewValidatingPeriodCollection constructs a new PeriodCollection that refuses to store invalid periods. Insert,
+// TryUpdate and TryExecute return the error from Period.Validate instead of storing a period that is not valid.
+//
+// Update and Execute cannot return an error, so they silently discard an update to an invalid period and leave the
+// collection unchanged. Callers of a validating collection should use TryUpdate and TryExecute instead.
+func NewValidatingPeriodCollection[K comparable, V any]() *PeriodCollection[K, V] {
+	pc := NewPeriodCollection[K, V]()
+	pc.validate = true
+	return pc
 }
 
 // Command is an interface that allows multiple operations on the PeriodCollection to be
@@ -68,10 +57,10 @@ type Command interface {
 
 // Update is a command that runs an update on the PeriodCollection
 type Update[K comparable, V any] struct {
+	pc          *PeriodCollection[K, V]
 	key         K
 	newContents V
 	newPeriod   Period
-	pc          *PeriodCollection[K, V]
 }
 
 // Delete is a command that runs a deletion on the PeriodCollection
@@ -82,513 +71,111 @@ type Delete[K comparable, V any] struct {
 
 // Insert adds a new period into the collection. The key parameter is a unique identifier that must be supplied
 // when inserting a new period. contents is an arbitrary object associated with the period inserted. If a period
-// already exists with the given key, an error will be returned.
+// already exists with the given key, or the collection validates periods and the period is invalid, an error will be
+// returned.
 func (pc *PeriodCollection[K, V]) Insert(key K, period Period, contents V) error {
-	pc.mutex.Lock()
-	defer pc.mutex.Unlock()
-	if _, ok := pc.nodes[key]; ok {
+	pc.intervals.mutex.Lock()
+	defer pc.intervals.mutex.Unlock()
+	if _, ok := pc.intervals.nodes[key]; ok {
 		return fmt.Errorf("period with key %v already exists", key)
 	}
-	pc.insert(key, period, contents)
-	return nil
-}
-
-// insert is the internal function that adds a new red node to the tree. Note this function does not lock the mutex,
-// that must be done by the caller.
-func (pc *PeriodCollection[K, V]) insert(key K, period Period, contents V) {
-	inserted := newNode[K, V](period, key, contents, red)
-	pc.nodes[key] = inserted
-	if pc.root == nil || pc.root.leaf {
-		inserted.color = black
-		pc.root = inserted
-	} else {
-		pc.insertRecursive(pc.root, inserted)
-		pc.insertRepair(inserted)
-	}
-}
-
-// insertRecursive recursively adds new red node containing a period and ID into the tree. The inserted node is stored in the
-// the inserted parameter.
-func (pc *PeriodCollection[K, V]) insertRecursive(root, inserted *node[K, V]) {
-	// augment the tree with the maximum end time of its subtree
-	if inserted.period.End.IsZero() {
-		root.maxEnd = inserted.period.End
-	} else if !root.maxEnd.IsZero() {
-		root.maxEnd = MaxTime(root.maxEnd, inserted.period.End)
-	}
-
-	if root.periodToLeft(inserted.period) {
-		if root.left.leaf {
-			inserted.parent = root
-			root.left = inserted
-			return
-		}
-		pc.insertRecursive(root.left, inserted)
-
-	} else {
-		if root.right.leaf {
-			inserted.parent = root
-			root.right = inserted
-			return
-		}
-		pc.insertRecursive(root.right, inserted)
-	}
-}
-
-// insertRepair rebalances the tree to maintain the red-black property after an insertion
-func (pc *PeriodCollection[K, V]) insertRepair(n *node[K, V]) {
-	if n == pc.root {
-		// n is the actual root of the tree, by definition pc is always black
-		n.color = black
-		return
-	}
-
-	if n.parent.color == black {
-		// the parent is already black so nothing has to be done
-		return
-	}
-
-	uncle := n.parent.sibling()
-	uncleColor := uncle.nodeColor()
-
-	if uncleColor == red {
-		// the parent is red; if pc has a red sibling, change parent & uncle to black and change grandparent to red
-		uncle.color = black
-		n.parent.color = black
-		if n.parent.parent != nil {
-			n.parent.parent.color = red
-			pc.insertRepair(n.parent.parent)
-			return
-		}
-	}
-
-	if uncleColor == black && n.parent.color == red {
-		// move n so that pc is on the same side of its parent as its parent is to its grandparent (i.e. pc is on the
-		// outside of the subtree)
-		isInsideRight := n.parent.isLeftChild() && !n.isLeftChild()
-		isInsideLeft := !n.parent.isLeftChild() && n.isLeftChild()
-		if isInsideRight {
-			pc.rotate(n.parent, left)
-			n = n.left
-		} else if isInsideLeft {
-			pc.rotate(n.parent, right)
-			n = n.right
-		}
-
-		// rotate again to move n into the grandparent's spot
-		n.parent.color = black
-		if n.parent.parent != nil {
-			n.parent.parent.color = red
-			if n.isLeftChild() {
-				pc.rotate(n.parent.parent, right)
-			} else {
-				pc.rotate(n.parent.parent, left)
-			}
-		}
-	}
-}
-
-// rotate rotates a node in the tree about node n either left or right.
-func (pc *PeriodCollection[K, V]) rotate(n *node[K, V], direction rotationDirection) {
-	// y is the node that is going to take the place of n in the tree
-	var y *node[K, V]
-	switch direction {
-	case right:
-		y = n.left
-	case left:
-		y = n.right
-	}
-
-	// move y into n's position
-	if n == pc.root {
-		pc.root = y
-	} else {
-		if n.isLeftChild() {
-			n.parent.left = y
-		} else {
-			n.parent.right = y
-		}
-	}
-	y.parent = n.parent
-
-	// rotate about n
-	switch direction {
-	case right:
-		n.left = y.right
-		y.right.parent = n
-		y.right = n
-	case left:
-		n.right = y.left
-		y.left.parent = n
-		y.left = n
-	}
-	n.parent = y
-	n.maxEnd = n.maxEndOfSubtree()
-	if !y.leaf {
-		y.maxEnd = y.maxEndOfSubtree()
+	if err := pc.validatePeriod(period); err != nil {
+		return err
 	}
+	pc.intervals.insert(key, period.Interval(), contents)
+	return nil
 }
 
 // Delete removes the period and its associated contents with the provided key. If no period with the provided
 // key exists, this function is a no-op.
 func (pc *PeriodCollection[K, V]) Delete(key K) {
-	pc.mutex.Lock()
-	defer pc.mutex.Unlock()
-	pc.delete(key)
-}
-
-// delete is the internal method that determines if a deletion is necessary, and if so, executes the deletion
-func (pc *PeriodCollection[K, V]) delete(key K) {
-	node, ok := pc.nodes[key]
-	if !ok {
-		return
-	}
-	pc.deleteNode(node)
-}
-
-// deleteNode removes the specified node from the tree
-func (pc *PeriodCollection[K, V]) deleteNode(n *node[K, V]) {
-	// y is the node that is going to be deleted, z is the node that will be moved into y's place
-	var y *node[K, V]
-	var z *node[K, V]
-
-	delete(pc.nodes, n.key)
-	if n.left.leaf || n.right.leaf {
-		// n has 0 or 1 children so pc can be deleted
-		y = n
-	} else {
-		// n is an internal node, delete its successor and swap the contents of its successor into n
-		y = n.successor()
-		pc.nodes[y.key] = n
-	}
-	if !y.left.leaf {
-		z = y.left
-	} else if !y.right.leaf {
-		z = y.right
-	} else {
-		z = &node[K, V]{leaf: true}
-	}
-	z.parent = y.parent
-
-	if y.parent != nil {
-		if y.isLeftChild() {
-			y.parent.left = z
-		} else {
-			y.parent.right = z
-		}
-	} else {
-		pc.root = z
-	}
-	n.period, n.key, n.contents = y.period, y.key, y.contents
-
-	// update maxEnd all the way up the tree
-	parent := z.parent
-	for parent != nil {
-		parent.maxEnd = parent.maxEndOfSubtree()
-		parent = parent.parent
-	}
-	n.maxEnd = n.maxEndOfSubtree()
-
-	if y.color == black {
-		pc.deleteRepair(z)
-	}
-}
-
-// deleteRepair rebalances the tree to maintain the red-black property after a deletion
-func (pc *PeriodCollection[K, V]) deleteRepair(n *node[K, V]) {
-	if n == pc.root || n.color == red {
-		n.color = black
-		return
-	}
-	pc.deleteRepairCase1(n)
-	if pc.deleteRepairCase2(n) {
-		pc.deleteRepair(n.parent)
-		return
-	}
-	pc.deleteRepairCase3(n)
-	pc.deleteRepairCase4(n)
-}
-
-// deleteRepairCase1 handles the case of the deleted node's sibling being red. changes the parent's color to red and
-// the sibling's color to black and rotates to make the sibling the parent.
-func (pc *PeriodCollection[K, V]) deleteRepairCase1(n *node[K, V]) {
-	sibling := n.sibling()
-	if sibling.nodeColor() == red {
-		sibling.color = black
-		n.parent.color = red
-		if n.isLeftChild() {
-			pc.rotate(n.parent, left)
-		} else {
-			pc.rotate(n.parent, right)
-		}
-	}
-}
-
-// deleteRepairCase2 handles the case of the deleted node's sibling being a leaf or the sibling and its children
-// colored black. It handles this case by changing the sibling to red and returns whether the parent needs
-// to be repaired.
-func (pc *PeriodCollection[K, V]) deleteRepairCase2(n *node[K, V]) bool {
-	sibling := n.sibling()
-	if sibling.leaf {
-		return true
-	}
-	numChildren := 0
-	if !sibling.left.leaf {
-		numChildren++
-	}
-	if !sibling.right.leaf {
-		numChildren++
-	}
-	if sibling.color == black && numChildren == 2 && sibling.left.color == black && sibling.right.color == black {
-		sibling.color = red
-		return true
-	}
-	return false
-}
-
-// deleteRepairCase3 handles the case of the node's sibling colored black with a red child on the right if the deleted
-// node is on the right, or a red child on the left if the deleted node is on the left. It handles this case by
-// recoloring the sibling red and recoloring the appropriate child to black then rotating to move the sibling up.
-func (pc *PeriodCollection[K, V]) deleteRepairCase3(n *node[K, V]) {
-	sibling := n.sibling()
-	if !sibling.leaf && sibling.nodeColor() == black {
-		if sibling.right.nodeColor() == red && !n.isLeftChild() {
-			sibling.color = red
-			sibling.right.color = black
-			pc.rotate(sibling, left)
-		} else if sibling.left.nodeColor() == red && n.isLeftChild() {
-			sibling.color = red
-			sibling.left.color = black
-			pc.rotate(sibling, right)
-		}
-	}
-}
-
-// deleteRepairCase4 handles the case of the sibling of n colored black with a red child on the right if the deleted
-// node is on the left, or the sibling having a red child on the left if the deleted node is on the right. It recolors
-// the appropriate child of the sibling, makes the sibling the same color as the parent, makes the parent black, and
-// rotates to move the sibling up.
-func (pc *PeriodCollection[K, V]) deleteRepairCase4(n *node[K, V]) {
-	sibling := n.sibling()
-	if !sibling.leaf && sibling.nodeColor() == black {
-		if sibling.left.nodeColor() == red && !n.isLeftChild() {
-			sibling.left.color = black
-			sibling.color = n.parent.color
-			n.parent.color = black
-			pc.rotate(n.parent, right)
-		} else if sibling.right.nodeColor() == red && n.isLeftChild() {
-			sibling.right.color = black
-			sibling.color = n.parent.color
-			n.parent.color = black
-			pc.rotate(n.parent, left)
-		}
-	}
+	pc.intervals.Delete(key)
 }
 
 // Update the period and associated contents with the given key. If no period with the given key exists,
 // a new period is inserted.
+//
+// If the collection was constructed with NewValidatingPeriodCollection and the new period is invalid, the update is
+// silently discarded and the collection is left unchanged, without any error being reported. Use TryUpdate on a
+// validating collection to find out whether the update was made.
 func (pc *PeriodCollection[K, V]) Update(key K, newPeriod Period, newContents V) {
-	pc.mutex.Lock()
-	defer pc.mutex.Unlock()
+	_ = pc.TryUpdate(key, newPeriod, newContents)
+}
+
+// TryUpdate updates the period and associated contents with the given key in the same way as Update, but returns an
+// error if the collection validates periods and the new period is invalid, in which case the collection is left
+// unchanged.
+func (pc *PeriodCollection[K, V]) TryUpdate(key K, newPeriod Period, newContents V) error {
+	pc.intervals.mutex.Lock()
+	defer pc.intervals.mutex.Unlock()
+	if err := pc.validatePeriod(newPeriod); err != nil {
+		return err
+	}
 	pc.update(key, newPeriod, newContents)
+	return nil
 }
 
 // update is the internal function that performs the update on the tree.
 func (pc *PeriodCollection[K, V]) update(key K, newPeriod Period, newContents V) {
-	oldNode, ok := pc.nodes[key]
-	if !ok {
-		pc.insert(key, newPeriod, newContents)
-		return
-	}
-	if oldNode.period.Equals(newPeriod) {
-		// if the period hasn't changed, just swap the contents
-		oldNode.contents = newContents
-		return
-	}
-	// if the period has changed, delete the old node and insert a new one
-	pc.deleteNode(oldNode)
-	pc.insert(key, newPeriod, newContents)
+	pc.intervals.update(key, newPeriod.Interval(), newContents)
 }
 
 // AnyContainsTime returns whether there is any stored period that contains the supplied time.
 func (pc *PeriodCollection[K, V]) AnyContainsTime(time time.Time) bool {
-	pc.mutex.RLock()
-	defer pc.mutex.RUnlock()
-	return pc.anyContainsTime(pc.root, time)
-}
-
-// anyContainsTime is the internal function that recursively searches the tree for the supplied time.
-func (pc *PeriodCollection[K, V]) anyContainsTime(root *node[K, V], time time.Time) bool {
-	if root.leaf {
-		return false
-	}
-	if root.period.ContainsTime(time, false) {
-		return true
-	}
-	if !root.left.leaf && (root.left.maxEnd.After(time) || root.left.maxEnd.IsZero()) {
-		return pc.anyContainsTime(root.left, time)
-	}
-	return pc.anyContainsTime(root.right, time)
+	return pc.intervals.AnyContains(time)
 }
 
 // ContainsTime will find and return the contents of all objects in a periodic collection that contain the given time.
+// The results are sorted by start time ascending.
 func (pc *PeriodCollection[K, V]) ContainsTime(time time.Time) []V {
-	pc.mutex.RLock()
-	defer pc.mutex.RUnlock()
-	results := make([]V, 0)
-	if pc.root.leaf {
-		return results
-	}
-	pc.containsTime(time, pc.root, &results)
-	return results
-}
-
-// containsTime is the recursive step of ContainsTime that will determine which branches should be traversed in the
-// periodic collection and append the contents of all nodes that contain the queried time.
-// This method traverses the tree in-order, meaning that the results returned are sorted by start time ascending.
-func (pc *PeriodCollection[K, V]) containsTime(time time.Time, root *node[K, V], results *[]V) {
-	if !root.left.leaf && (root.left.maxEnd.After(time) || root.left.maxEnd.IsZero()) {
-		pc.containsTime(time, root.left, results)
-	}
-	if root.period.ContainsTime(time, false) {
-		*results = append(*results, root.contents)
-	}
-	// The current node (root) has the earliest start time of any node in the right subtree.
-	// If the period root.period.Start to root.right.maxEnd does not contain the queried time, it is guaranteed
-	// that there are no nodes in the right subtree that contain the time so the traversal can be skipped.
-	if !root.right.leaf && NewPeriod(root.period.Start, root.right.maxEnd).ContainsTime(time, false) {
-		pc.containsTime(time, root.right, results)
-	}
+	return pc.intervals.Containing(time)
 }
 
 // Intersecting returns the contents of all objects whose associated periods intersect the supplied query period.
 // Period intersection is inclusive on the start time but exclusive on the end time. The results returned by
 // Intersecting are sorted in ascending order by the associated period's start time.
 func (pc *PeriodCollection[K, V]) Intersecting(query Period) []V {
-	pc.mutex.RLock()
-	defer pc.mutex.RUnlock()
-	results := make([]V, 0)
-	if pc.root.leaf {
-		return results
-	}
-	pc.intersecting(query, pc.root, &results)
-	return results
-}
-
-// intersecting is the recursive step of Intersecting that will determine which branches should be traversed in the
-// periodic collection and append the contents of all nodes that intersect the queried period.
-// This method traverses the tree in-order, meaning that the results returned are sorted by start time ascending.
-func (pc *PeriodCollection[K, V]) intersecting(query Period, root *node[K, V], results *[]V) {
-	if !root.left.leaf && (root.left.maxEnd.After(query.Start) || root.left.maxEnd.IsZero()) {
-		pc.intersecting(query, root.left, results)
-	}
-	if root.period.Intersects(query) {
-		*results = append(*results, root.contents)
-	}
-	// The current node (root) has the earliest start time of any node in the right subtree.
-	// If the period root.period.Start to root.right.maxEnd does not intersect the queried period, it is guaranteed
-	// that there are no nodes in the right subtree that intersect the period so the traversal can be skipped.
-	if !root.right.leaf && NewPeriod(root.period.Start, root.right.maxEnd).Intersects(query) {
-		pc.intersecting(query, root.right, results)
-	}
+	return pc.intervals.Intersecting(query.Interval())
 }
 
 // AnyIntersecting returns whether or not there are any periods in the collection that intersect the query period.
 // Compared to Intersecting, this method is more efficient because it will terminate early once an intersection is
 // found.
 func (pc *PeriodCollection[K, V]) AnyIntersecting(query Period) bool {
-	pc.mutex.RLock()
-	defer pc.mutex.RUnlock()
-	if pc.root.leaf {
-		return false
-	}
-	return pc.anyIntersecting(query, pc.root)
-}
-
-// anyIntersecting is the internal function that recursively searches the tree and notifies the caller on the
-// found channel whether or not it has found an intersection.
-func (pc *PeriodCollection[K, V]) anyIntersecting(query Period, root *node[K, V]) bool {
-	if root.period.Intersects(query) {
-		return true
-	}
-	if !root.left.leaf && (root.left.maxEnd.After(query.Start) || root.left.maxEnd.IsZero()) {
-		return pc.anyIntersecting(query, root.left)
-	}
-	if !root.right.leaf && (root.right.maxEnd.After(query.Start) || root.right.maxEnd.IsZero()) {
-		return pc.anyIntersecting(query, root.right)
-	}
-	return false
+	return pc.intervals.AnyIntersecting(query.Interval())
 }
 
 // ContainsKey returns whether or not a period with a corresponding key exists.
 func (pc *PeriodCollection[K, V]) ContainsKey(key K) bool {
-	pc.mutex.RLock()
-	defer pc.mutex.RUnlock()
-	_, ok := pc.nodes[key]
-	return ok
+	return pc.intervals.ContainsKey(key)
 }
 
 // DepthFirstTraverse traverses the period collection's backing tree depth-first and returns the contents of every
 // node in the tree by the ordering given.
 func (pc *PeriodCollection[K, V]) DepthFirstTraverse(order TraversalOrder) []V {
-	pc.mutex.RLock()
-	defer pc.mutex.RUnlock()
-	nodeContents := make([]V, 0, len(pc.nodes))
-	pc.depthFirstTraverse(pc.root, order, &nodeContents)
-	return nodeContents
-}
-
-// depthFirstTraverse is the internal recursive function for traversing the interval tree.
-func (pc *PeriodCollection[K, V]) depthFirstTraverse(n *node[K, V], order TraversalOrder, visitedContents *[]V) {
-	if n.leaf {
-		return
-	}
-	if order == PreOrder {
-		*visitedContents = append(*visitedContents, n.contents)
-	}
-	pc.depthFirstTraverse(n.left, order, visitedContents)
-	if order == InOrder {
-		*visitedContents = append(*visitedContents, n.contents)
-	}
-	pc.depthFirstTraverse(n.right, order, visitedContents)
-	if order == PostOrder {
-		*visitedContents = append(*visitedContents, n.contents)
-	}
+	return pc.intervals.DepthFirstTraverse(order)
 }
 
 // ContentsOfKey returns the contents stored at the provided key in the collection. This method
 // runs in O(1) time and can be used if the key is known but not the period.
 func (pc *PeriodCollection[K, V]) ContentsOfKey(key K) (V, error) {
-	pc.mutex.RLock()
-	defer pc.mutex.RUnlock()
-	node, ok := pc.nodes[key]
-	if !ok {
-		var result V
-		return result, fmt.Errorf("key %v does not exist", key)
-	}
-	return node.contents, nil
+	return pc.intervals.ContentsOfKey(key)
 }
 
 // DeleteOnCondition will delete all nodes in the collection with contents that satisfy the given condition
 // Note that this method can be time consuming for large trees and multiple deletions as it may involve multiple
 // tree rotations.
 func (pc *PeriodCollection[K, V]) DeleteOnCondition(condition func(contents V) bool) {
-	pc.mutex.Lock()
-	defer pc.mutex.Unlock()
-	for _, node := range pc.nodes {
-		if condition(node.contents) {
-			pc.deleteNode(node)
-		}
-	}
+	pc.intervals.DeleteOnCondition(condition)
 }
 
 // PrepareUpdate returns an Update command that can be later be used for bulk actions on the collection
 func (pc *PeriodCollection[K, V]) PrepareUpdate(key K, newPeriod Period, newContents V) Update[K, V] {
 	return Update[K, V]{
+		pc:          pc,
 		key:         key,
 		newContents: newContents,
 		newPeriod:   newPeriod,
-		pc:          pc,
 	}
 }
 
@@ -599,12 +186,38 @@ func (pc *PeriodCollection[K, V]) PrepareDelete(key K) Delete[K, V] {
 
 // Execute takes a list of commands and runs all of them on the PeriodCollection within the context
 // of one write lock.
+//
+// If the collection was constructed with NewValidatingPeriodCollection and any of the commands would store an invalid
+// period, all of the commands are silently discarded and the collection is left unchanged, without any error being
+// reported. Use TryExecute on a validating collection to find out whether the commands were run.
 func (pc *PeriodCollection[K, V]) Execute(commands ...Command) {
-	pc.mutex.Lock()
-	defer pc.mutex.Unlock()
+	_ = pc.TryExecute(commands...)
+}
+
+// TryExecute runs the commands in the same way as Execute, but returns the first error if the collection validates
+// periods and any of the commands would store an invalid period, in which case none of the commands are run.
+func (pc *PeriodCollection[K, V]) TryExecute(commands ...Command) error {
+	pc.intervals.mutex.Lock()
+	defer pc.intervals.mutex.Unlock()
+	for _, command := range commands {
+		if u, ok := command.(Update[K, V]); ok {
+			if err := u.pc.validatePeriod(u.newPeriod); err != nil {
+				return err
+			}
+		}
+	}
 	for _, command := range commands {
 		command.execute()
 	}
+	return nil
+}
+
+// validatePeriod returns an error if the collection validates periods and the period is invalid.
+func (pc *PeriodCollection[K, V]) validatePeriod(period Period) error {
+	if !pc.validate {
+		return nil
+	}
+	return period.Validate()
 }
 
 // execute the update on the tree
@@ -614,5 +227,5 @@ func (u Update[K, V]) execute() {
 
 // execute the delete on the tree
 func (d Delete[K, V]) execute() {
-	d.pc.delete(d.key)
+	d.pc.intervals.delete(d.key)
 }
@@ -22,6 +22,21 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// periodCollectionOf returns a PeriodCollection whose backing tree has the given root and nodes, either of which may be
+// nil to leave it empty
+func periodCollectionOf[K comparable, V any](
+	root *node[time.Time, K, V], nodes map[K]*node[time.Time, K, V],
+) *PeriodCollection[K, V] {
+	pc := NewPeriodCollection[K, V]()
+	if root != nil {
+		pc.intervals.root = root
+	}
+	if nodes != nil {
+		pc.intervals.nodes = nodes
+	}
+	return pc
+}
+
 func TestPeriodCollection_Insert(t *testing.T) {
 	type insertions struct {
 		period    Period
@@ -39,25 +54,25 @@ func TestPeriodCollection_Insert(t *testing.T) {
 			setupTree:  func() *PeriodCollection[int, insertions] { return NewPeriodCollection[int, insertions]() },
 			insertions: []insertions{{NewPeriod(time.Unix(1, 0), time.Unix(5, 0)), 0, false}},
 			validateTree: func(t *testing.T, pc *PeriodCollection[int, insertions]) {
-				assert.Equal(t, black, pc.root.color)
-				assert.Contains(t, pc.nodes, 0)
-				assert.Equal(t, time.Unix(5, 0), pc.root.maxEnd)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Contains(t, pc.intervals.nodes, 0)
+				assert.Equal(t, time.Unix(5, 0), pc.intervals.root.maxEnd)
 			},
 		}, {
 			name: "inserting a node into a tree with a sentinel root replaces the sentinel with a new root",
 			setupTree: func() *PeriodCollection[int, insertions] {
 				pc := NewPeriodCollection[int, insertions]()
-				pc.root = &node[int, insertions]{leaf: true}
+				pc.intervals.root = &node[time.Time, int, insertions]{leaf: true}
 				return pc
 			},
 			insertions: []insertions{{NewPeriod(time.Unix(1, 0), time.Unix(5, 0)), 0, false}},
 			validateTree: func(t *testing.T, pc *PeriodCollection[int, insertions]) {
-				assert.Equal(t, black, pc.root.color)
-				assert.False(t, pc.root.leaf)
-				assert.Equal(t, time.Unix(1, 0), pc.root.period.Start)
-				assert.Contains(t, pc.nodes, 0)
-				assert.Len(t, pc.nodes, 1)
-				assert.Equal(t, time.Unix(5, 0), pc.root.maxEnd)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.False(t, pc.intervals.root.leaf)
+				assert.Equal(t, time.Unix(1, 0), pc.intervals.root.interval.Start)
+				assert.Contains(t, pc.intervals.nodes, 0)
+				assert.Len(t, pc.intervals.nodes, 1)
+				assert.Equal(t, time.Unix(5, 0), pc.intervals.root.maxEnd)
 			},
 		}, {
 			/* after insertion, 1 and 3 are red and 2 is black
@@ -73,21 +88,21 @@ func TestPeriodCollection_Insert(t *testing.T) {
 				{NewPeriod(time.Unix(3, 0), time.Unix(4, 0)), 2, false},
 			},
 			validateTree: func(t *testing.T, pc *PeriodCollection[int, insertions]) {
-				require.NotNil(t, pc.root.left)
-				require.NotNil(t, pc.root.right)
-				assert.Equal(t, time.Unix(2, 0), pc.root.period.Start)
-				assert.Equal(t, time.Unix(1, 0), pc.root.left.period.Start)
-				assert.Equal(t, time.Unix(3, 0), pc.root.right.period.Start)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, red, pc.root.left.color)
-				assert.Equal(t, red, pc.root.right.color)
+				require.NotNil(t, pc.intervals.root.left)
+				require.NotNil(t, pc.intervals.root.right)
+				assert.Equal(t, time.Unix(2, 0), pc.intervals.root.interval.Start)
+				assert.Equal(t, time.Unix(1, 0), pc.intervals.root.left.interval.Start)
+				assert.Equal(t, time.Unix(3, 0), pc.intervals.root.right.interval.Start)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, red, pc.intervals.root.left.color)
+				assert.Equal(t, red, pc.intervals.root.right.color)
 				for i := 0; i < 3; i++ {
-					assert.Contains(t, pc.nodes, i)
+					assert.Contains(t, pc.intervals.nodes, i)
 				}
-				assert.Len(t, pc.nodes, 3)
-				assert.Equal(t, time.Unix(10, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(10, 0), pc.root.left.maxEnd)
-				assert.Equal(t, time.Unix(4, 0), pc.root.right.maxEnd)
+				assert.Len(t, pc.intervals.nodes, 3)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.left.maxEnd)
+				assert.Equal(t, time.Unix(4, 0), pc.intervals.root.right.maxEnd)
 			},
 		}, {
 			/* Nodes will be inserted and should be rotated and rebalanced such that 1 and 3 are red
@@ -105,22 +120,22 @@ func TestPeriodCollection_Insert(t *testing.T) {
 				{NewPeriod(time.Unix(3, 0), time.Unix(10, 0)), 2, false},
 			},
 			validateTree: func(t *testing.T, pc *PeriodCollection[int, insertions]) {
-				require.NotNil(t, pc.root.left)
-				require.NotNil(t, pc.root.right)
-				assert.Equal(t, time.Unix(2, 0), pc.root.period.Start)
-				assert.Equal(t, time.Unix(1, 0), pc.root.left.period.Start)
-				assert.Equal(t, time.Unix(3, 0), pc.root.right.period.Start)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, red, pc.root.left.color)
-				assert.Equal(t, red, pc.root.right.color)
+				require.NotNil(t, pc.intervals.root.left)
+				require.NotNil(t, pc.intervals.root.right)
+				assert.Equal(t, time.Unix(2, 0), pc.intervals.root.interval.Start)
+				assert.Equal(t, time.Unix(1, 0), pc.intervals.root.left.interval.Start)
+				assert.Equal(t, time.Unix(3, 0), pc.intervals.root.right.interval.Start)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, red, pc.intervals.root.left.color)
+				assert.Equal(t, red, pc.intervals.root.right.color)
 				for i := 0; i < 3; i++ {
-					assert.Contains(t, pc.nodes, i)
+					assert.Contains(t, pc.intervals.nodes, i)
 				}
-				assert.Len(t, pc.nodes, 3)
-				assert.Len(t, pc.nodes, 3)
-				assert.Equal(t, time.Unix(10, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(5, 0), pc.root.left.maxEnd)
-				assert.Equal(t, time.Unix(10, 0), pc.root.right.maxEnd)
+				assert.Len(t, pc.intervals.nodes, 3)
+				assert.Len(t, pc.intervals.nodes, 3)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(5, 0), pc.intervals.root.left.maxEnd)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.right.maxEnd)
 			},
 		}, {
 			/* 20 is black, 10, 30 are red to start, inserting 35 should make nodes 10 and 30 black
@@ -132,30 +147,30 @@ func TestPeriodCollection_Insert(t *testing.T) {
 			*/
 			name: "inserting a new node beneath red nodes changes the parents to black",
 			setupTree: func() *PeriodCollection[int, insertions] {
-				twenty := newNode[int, insertions](NewPeriod(time.Unix(20, 0), time.Unix(25, 0)), 0, insertions{}, black)
-				ten := newNode(NewPeriod(time.Unix(10, 0), time.Unix(22, 0)), 0, insertions{}, red)
-				thirty := newNode(NewPeriod(time.Unix(30, 0), time.Unix(100, 0)), 0, insertions{}, red)
-				twenty.left, twenty.right, twenty.maxEnd = ten, thirty, thirty.period.End
-				ten.parent, ten.maxEnd = twenty, ten.period.End
-				thirty.parent, thirty.maxEnd = twenty, thirty.period.End
+				twenty := newNode[time.Time, int, insertions](NewPeriod(time.Unix(20, 0), time.Unix(25, 0)).Interval(), 0, insertions{}, black)
+				ten := newNode(NewPeriod(time.Unix(10, 0), time.Unix(22, 0)).Interval(), 0, insertions{}, red)
+				thirty := newNode(NewPeriod(time.Unix(30, 0), time.Unix(100, 0)).Interval(), 0, insertions{}, red)
+				twenty.left, twenty.right, twenty.maxEnd = ten, thirty, thirty.interval.End
+				ten.parent, ten.maxEnd = twenty, ten.interval.End
+				thirty.parent, thirty.maxEnd = twenty, thirty.interval.End
 				pc := NewPeriodCollection[int, insertions]()
-				pc.root = twenty
+				pc.intervals.root = twenty
 				return pc
 			},
 			insertions: []insertions{{NewPeriod(time.Unix(35, 0), time.Unix(50, 0)), 0, false}},
 			validateTree: func(t *testing.T, pc *PeriodCollection[int, insertions]) {
-				assert.Equal(t, time.Unix(20, 0), pc.root.period.Start)
-				assert.Equal(t, time.Unix(10, 0), pc.root.left.period.Start)
-				assert.Equal(t, time.Unix(30, 0), pc.root.right.period.Start)
-				assert.Equal(t, time.Unix(35, 0), pc.root.right.right.period.Start)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, red, pc.root.right.right.color)
-				assert.Equal(t, time.Unix(100, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(22, 0), pc.root.left.maxEnd)
-				assert.Equal(t, time.Unix(100, 0), pc.root.right.maxEnd)
-				assert.Equal(t, time.Unix(50, 0), pc.root.right.right.maxEnd)
+				assert.Equal(t, time.Unix(20, 0), pc.intervals.root.interval.Start)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.left.interval.Start)
+				assert.Equal(t, time.Unix(30, 0), pc.intervals.root.right.interval.Start)
+				assert.Equal(t, time.Unix(35, 0), pc.intervals.root.right.right.interval.Start)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, red, pc.intervals.root.right.right.color)
+				assert.Equal(t, time.Unix(100, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(22, 0), pc.intervals.root.left.maxEnd)
+				assert.Equal(t, time.Unix(100, 0), pc.intervals.root.right.maxEnd)
+				assert.Equal(t, time.Unix(50, 0), pc.intervals.root.right.right.maxEnd)
 			},
 		}, {
 			/* 20 is black, 30 is red to start, inserting 25 should rebalance the tree with multiple left rotations
@@ -167,25 +182,25 @@ func TestPeriodCollection_Insert(t *testing.T) {
 			*/
 			name: "inserting a new left inside performs multiple rotations to balance the tree",
 			setupTree: func() *PeriodCollection[int, insertions] {
-				twenty := newNode[int, insertions](NewPeriod(time.Unix(20, 0), time.Unix(50, 0)), 0, insertions{}, black)
-				thirty := newNode[int, insertions](NewPeriod(time.Unix(30, 0), time.Unix(75, 0)), 0, insertions{}, red)
-				twenty.right, twenty.maxEnd = thirty, thirty.period.End
-				thirty.parent, thirty.maxEnd = twenty, thirty.period.End
+				twenty := newNode[time.Time, int, insertions](NewPeriod(time.Unix(20, 0), time.Unix(50, 0)).Interval(), 0, insertions{}, black)
+				thirty := newNode[time.Time, int, insertions](NewPeriod(time.Unix(30, 0), time.Unix(75, 0)).Interval(), 0, insertions{}, red)
+				twenty.right, twenty.maxEnd = thirty, thirty.interval.End
+				thirty.parent, thirty.maxEnd = twenty, thirty.interval.End
 				pc := NewPeriodCollection[int, insertions]()
-				pc.root = twenty
+				pc.intervals.root = twenty
 				return pc
 			},
 			insertions: []insertions{{NewPeriod(time.Unix(25, 0), time.Unix(100, 0)), 0, false}},
 			validateTree: func(t *testing.T, pc *PeriodCollection[int, insertions]) {
-				assert.Equal(t, time.Unix(25, 0), pc.root.period.Start)
-				assert.Equal(t, time.Unix(20, 0), pc.root.left.period.Start)
-				assert.Equal(t, time.Unix(30, 0), pc.root.right.period.Start)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, red, pc.root.left.color)
-				assert.Equal(t, red, pc.root.right.color)
-				assert.Equal(t, time.Unix(100, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(50, 0), pc.root.left.maxEnd)
-				assert.Equal(t, time.Unix(75, 0), pc.root.right.maxEnd)
+				assert.Equal(t, time.Unix(25, 0), pc.intervals.root.interval.Start)
+				assert.Equal(t, time.Unix(20, 0), pc.intervals.root.left.interval.Start)
+				assert.Equal(t, time.Unix(30, 0), pc.intervals.root.right.interval.Start)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, red, pc.intervals.root.left.color)
+				assert.Equal(t, red, pc.intervals.root.right.color)
+				assert.Equal(t, time.Unix(100, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(50, 0), pc.intervals.root.left.maxEnd)
+				assert.Equal(t, time.Unix(75, 0), pc.intervals.root.right.maxEnd)
 			},
 		}, {
 			/* 25 is black, 15 is red to start, inserting 20 should rebalance the tree with multiple right rotations
@@ -197,25 +212,25 @@ func TestPeriodCollection_Insert(t *testing.T) {
 			*/
 			name: "inserting a new right inside performs multiple rotations to balance the tree",
 			setupTree: func() *PeriodCollection[int, insertions] {
-				twentyFive := newNode[int, insertions](NewPeriod(time.Unix(25, 0), time.Unix(45, 0)), 0, insertions{}, black)
-				fifteen := newNode[int, insertions](NewPeriod(time.Unix(15, 0), time.Unix(20, 0)), 0, insertions{}, red)
-				twentyFive.left, twentyFive.maxEnd = fifteen, twentyFive.period.End
-				fifteen.parent, fifteen.maxEnd = twentyFive, fifteen.period.End
+				twentyFive := newNode[time.Time, int, insertions](NewPeriod(time.Unix(25, 0), time.Unix(45, 0)).Interval(), 0, insertions{}, black)
+				fifteen := newNode[time.Time, int, insertions](NewPeriod(time.Unix(15, 0), time.Unix(20, 0)).Interval(), 0, insertions{}, red)
+				twentyFive.left, twentyFive.maxEnd = fifteen, twentyFive.interval.End
+				fifteen.parent, fifteen.maxEnd = twentyFive, fifteen.interval.End
 				pc := NewPeriodCollection[int, insertions]()
-				pc.root = twentyFive
+				pc.intervals.root = twentyFive
 				return pc
 			},
 			insertions: []insertions{{NewPeriod(time.Unix(20, 0), time.Unix(40, 0)), 0, false}},
 			validateTree: func(t *testing.T, pc *PeriodCollection[int, insertions]) {
-				assert.Equal(t, time.Unix(20, 0), pc.root.period.Start)
-				assert.Equal(t, time.Unix(15, 0), pc.root.left.period.Start)
-				assert.Equal(t, time.Unix(25, 0), pc.root.right.period.Start)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, red, pc.root.left.color)
-				assert.Equal(t, red, pc.root.right.color)
-				assert.Equal(t, time.Unix(45, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(45, 0), pc.root.right.maxEnd)
-				assert.Equal(t, time.Unix(20, 0), pc.root.left.maxEnd)
+				assert.Equal(t, time.Unix(20, 0), pc.intervals.root.interval.Start)
+				assert.Equal(t, time.Unix(15, 0), pc.intervals.root.left.interval.Start)
+				assert.Equal(t, time.Unix(25, 0), pc.intervals.root.right.interval.Start)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, red, pc.intervals.root.left.color)
+				assert.Equal(t, red, pc.intervals.root.right.color)
+				assert.Equal(t, time.Unix(45, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(45, 0), pc.intervals.root.right.maxEnd)
+				assert.Equal(t, time.Unix(20, 0), pc.intervals.root.left.maxEnd)
 			},
 		}, {
 			name:      "inserting a node with the same key as an existing node returns an error",
@@ -225,35 +240,35 @@ func TestPeriodCollection_Insert(t *testing.T) {
 				{NewPeriod(time.Unix(20, 0), time.Unix(40, 0)), 0, true},
 			},
 			validateTree: func(t *testing.T, pc *PeriodCollection[int, insertions]) {
-				assert.Len(t, pc.nodes, 1)
+				assert.Len(t, pc.intervals.nodes, 1)
 			},
 		}, {
 			name: "inserting a node on the left with an unbounded period updates maxEnd correctly",
 			setupTree: func() *PeriodCollection[int, insertions] {
 				pc := NewPeriodCollection[int, insertions]()
-				pc.root = newNode[int, insertions](NewPeriod(time.Unix(20, 0), time.Unix(25, 0)), 0, insertions{}, black)
+				pc.intervals.root = newNode[time.Time, int, insertions](NewPeriod(time.Unix(20, 0), time.Unix(25, 0)).Interval(), 0, insertions{}, black)
 				return pc
 			},
 			insertions: []insertions{{NewPeriod(time.Unix(10, 0), time.Time{}), 0, false}},
 			validateTree: func(t *testing.T, pc *PeriodCollection[int, insertions]) {
-				assert.Equal(t, time.Unix(20, 0), pc.root.period.Start)
-				assert.Equal(t, time.Unix(10, 0), pc.root.left.period.Start)
-				assert.Equal(t, time.Time{}, pc.root.maxEnd)
-				assert.Equal(t, time.Time{}, pc.root.left.maxEnd)
+				assert.Equal(t, time.Unix(20, 0), pc.intervals.root.interval.Start)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.left.interval.Start)
+				assert.Equal(t, time.Time{}, pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Time{}, pc.intervals.root.left.maxEnd)
 			},
 		}, {
 			name: "inserting a node on the right with an unbounded period updates maxEnd correctly",
 			setupTree: func() *PeriodCollection[int, insertions] {
 				pc := NewPeriodCollection[int, insertions]()
-				pc.root = newNode[int, insertions](NewPeriod(time.Unix(20, 0), time.Unix(25, 0)), 0, insertions{}, black)
+				pc.intervals.root = newNode[time.Time, int, insertions](NewPeriod(time.Unix(20, 0), time.Unix(25, 0)).Interval(), 0, insertions{}, black)
 				return pc
 			},
 			insertions: []insertions{{NewPeriod(time.Unix(30, 0), time.Time{}), 0, false}},
 			validateTree: func(t *testing.T, pc *PeriodCollection[int, insertions]) {
-				assert.Equal(t, time.Unix(20, 0), pc.root.period.Start)
-				assert.Equal(t, time.Unix(30, 0), pc.root.right.period.Start)
-				assert.Equal(t, time.Time{}, pc.root.maxEnd)
-				assert.Equal(t, time.Time{}, pc.root.right.maxEnd)
+				assert.Equal(t, time.Unix(20, 0), pc.intervals.root.interval.Start)
+				assert.Equal(t, time.Unix(30, 0), pc.intervals.root.right.interval.Start)
+				assert.Equal(t, time.Time{}, pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Time{}, pc.intervals.root.right.maxEnd)
 			},
 		},
 	}
@@ -274,36 +289,36 @@ func TestPeriodCollection_Insert(t *testing.T) {
 }
 
 func TestPeriodCollection_rotate(t *testing.T) {
-	nodeA := &node[int, any]{}
-	nodeB := &node[int, any]{}
-	nodeC := &node[int, any]{}
-	nodeD := &node[int, any]{}
+	nodeA := &node[time.Time, int, any]{}
+	nodeB := &node[time.Time, int, any]{}
+	nodeC := &node[time.Time, int, any]{}
+	nodeD := &node[time.Time, int, any]{}
 	cleanupTree := func() {
-		for _, n := range []*node[int, any]{nodeA, nodeB, nodeC, nodeD} {
-			n.left, n.right, n.parent = &node[int, any]{leaf: true}, &node[int, any]{leaf: true}, nil
+		for _, n := range []*node[time.Time, int, any]{nodeA, nodeB, nodeC, nodeD} {
+			n.left, n.right, n.parent = &node[time.Time, int, any]{leaf: true}, &node[time.Time, int, any]{leaf: true}, nil
 		}
 	}
 	setupLeftTree := func() *PeriodCollection[int, any] {
 		cleanupTree()
-		nodeD.left, nodeD.period.End, nodeD.maxEnd = nodeC, time.Unix(1, 0), time.Unix(10, 0)
-		nodeC.left, nodeC.right, nodeC.parent, nodeC.period.End, nodeC.maxEnd =
+		nodeD.left, nodeD.interval.End, nodeD.maxEnd = nodeC, time.Unix(1, 0), time.Unix(10, 0)
+		nodeC.left, nodeC.right, nodeC.parent, nodeC.interval.End, nodeC.maxEnd =
 			nodeA, nodeB, nodeD, time.Unix(2, 0), time.Unix(10, 0)
-		nodeA.parent, nodeA.period.End, nodeA.maxEnd = nodeC, time.Unix(10, 0), time.Unix(10, 0)
-		nodeB.parent, nodeB.period.End, nodeB.maxEnd = nodeC, time.Unix(5, 0), time.Unix(5, 0)
-		return &PeriodCollection[int, any]{root: nodeD}
+		nodeA.parent, nodeA.interval.End, nodeA.maxEnd = nodeC, time.Unix(10, 0), time.Unix(10, 0)
+		nodeB.parent, nodeB.interval.End, nodeB.maxEnd = nodeC, time.Unix(5, 0), time.Unix(5, 0)
+		return periodCollectionOf[int, any](nodeD, nil)
 	}
 	setupRightTree := func() *PeriodCollection[int, any] {
 		cleanupTree()
-		nodeD.right, nodeD.period.End, nodeD.maxEnd = nodeC, time.Unix(1, 0), time.Unix(10, 0)
-		nodeC.left, nodeC.right, nodeC.parent, nodeC.period.End, nodeC.maxEnd =
+		nodeD.right, nodeD.interval.End, nodeD.maxEnd = nodeC, time.Unix(1, 0), time.Unix(10, 0)
+		nodeC.left, nodeC.right, nodeC.parent, nodeC.interval.End, nodeC.maxEnd =
 			nodeA, nodeB, nodeD, time.Unix(2, 0), time.Unix(10, 0)
-		nodeA.parent, nodeA.period.End, nodeA.maxEnd = nodeC, time.Unix(10, 0), time.Unix(10, 0)
-		nodeB.parent, nodeB.period.End, nodeB.maxEnd = nodeC, time.Unix(5, 0), time.Unix(5, 0)
-		return &PeriodCollection[int, any]{root: nodeD}
+		nodeA.parent, nodeA.interval.End, nodeA.maxEnd = nodeC, time.Unix(10, 0), time.Unix(10, 0)
+		nodeB.parent, nodeB.interval.End, nodeB.maxEnd = nodeC, time.Unix(5, 0), time.Unix(5, 0)
+		return periodCollectionOf[int, any](nodeD, nil)
 	}
 	tests := []struct {
 		setupTree    func() *PeriodCollection[int, any]
-		nodeToRotate *node[int, any]
+		nodeToRotate *node[time.Time, int, any]
 		validateTree func(t *testing.T)
 		name         string
 		direction    rotationDirection
@@ -437,7 +452,7 @@ func TestPeriodCollection_rotate(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			tree := test.setupTree()
-			tree.rotate(test.nodeToRotate, test.direction)
+			tree.intervals.rotate(test.nodeToRotate, test.direction)
 			test.validateTree(t)
 		})
 	}
@@ -453,12 +468,12 @@ func TestPeriodCollection_successor(t *testing.T) {
 	     \
 	      D
 	*/
-	nodeA := newNode[int, any](Period{}, 0, nil, black)
-	nodeB := newNode[int, any](Period{}, 0, nil, black)
-	nodeC := newNode[int, any](Period{}, 0, nil, black)
-	nodeD := newNode[int, any](Period{}, 0, nil, black)
-	nodeE := newNode[int, any](Period{}, 0, nil, black)
-	nodeF := newNode[int, any](Period{}, 0, nil, black)
+	nodeA := newNode[time.Time, int, any](Period{}.Interval(), 0, nil, black)
+	nodeB := newNode[time.Time, int, any](Period{}.Interval(), 0, nil, black)
+	nodeC := newNode[time.Time, int, any](Period{}.Interval(), 0, nil, black)
+	nodeD := newNode[time.Time, int, any](Period{}.Interval(), 0, nil, black)
+	nodeE := newNode[time.Time, int, any](Period{}.Interval(), 0, nil, black)
+	nodeF := newNode[time.Time, int, any](Period{}.Interval(), 0, nil, black)
 	nodeE.left = nodeB
 	nodeE.right = nodeF
 	nodeB.parent = nodeE
@@ -470,8 +485,8 @@ func TestPeriodCollection_successor(t *testing.T) {
 	nodeC.right = nodeD
 	nodeD.parent = nodeC
 	tests := []struct {
-		successorOf       *node[int, any]
-		expectedSuccessor *node[int, any]
+		successorOf       *node[time.Time, int, any]
+		expectedSuccessor *node[time.Time, int, any]
 		name              string
 	}{
 		{
@@ -513,11 +528,8 @@ func TestPeriodCollection_Delete(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(_ *testing.T) {
-			n := newNode[int, any](Period{}, 1, nil, black)
-			pc := PeriodCollection[int, any]{
-				root:  n,
-				nodes: map[int]*node[int, any]{1: n},
-			}
+			n := newNode[time.Time, int, any](Period{}.Interval(), 1, nil, black)
+			pc := periodCollectionOf[int, any](n, map[int]*node[time.Time, int, any]{1: n})
 			pc.Delete(test.key)
 		})
 	}
@@ -525,22 +537,22 @@ func TestPeriodCollection_Delete(t *testing.T) {
 
 func TestPeriodCollection_deleteNode(t *testing.T) {
 	tests := []struct {
-		setupTree func() (*PeriodCollection[int, any], *node[int, any])
+		setupTree func() (*PeriodCollection[int, any], *node[time.Time, int, any])
 		validate  func(t *testing.T, pc *PeriodCollection[int, any])
 		name      string
 	}{
 		{
 			name: "deleting the a tree with only root leaves a leaf as the root",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				root := newNode[int, any](Period{}, 1, nil, black)
-				return &PeriodCollection[int, any]{root: root, nodes: map[int]*node[int, any]{1: root}}, root
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				root := newNode[time.Time, int, any](Period{}.Interval(), 1, nil, black)
+				return periodCollectionOf[int, any](root, map[int]*node[time.Time, int, any]{1: root}), root
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.True(t, pc.root.leaf)
-				assert.Nil(t, pc.root.left)
-				assert.Nil(t, pc.root.right)
-				assert.Nil(t, pc.root.parent)
-				assert.NotContains(t, pc.nodes, 1)
+				assert.True(t, pc.intervals.root.leaf)
+				assert.Nil(t, pc.intervals.root.left)
+				assert.Nil(t, pc.intervals.root.right)
+				assert.Nil(t, pc.intervals.root.parent)
+				assert.NotContains(t, pc.intervals.nodes, 1)
 			},
 		}, {
 			/* P, S, N are black, L, R are red; after deleting N, L is red with the rest black
@@ -551,37 +563,34 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			L   R       L
 			*/
 			name: "deleting a black right child with no children with a black sibling with red children rebalances the tree",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{End: time.Unix(40, 0)}, 1, "p", black)
-				s := newNode[int, any](Period{End: time.Unix(50, 0)}, 2, "s", black)
-				n := newNode[int, any](Period{End: time.Unix(45, 0)}, 3, "n", black)
-				l := newNode[int, any](Period{End: time.Unix(25, 0)}, 4, "l", red)
-				r := newNode[int, any](Period{End: time.Unix(60, 0)}, 5, "r", red)
-				p.left, p.right, p.maxEnd = s, n, r.period.End
-				s.left, s.right, s.parent, s.maxEnd = l, r, p, r.period.End
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{End: time.Unix(40, 0)}.Interval(), 1, "p", black)
+				s := newNode[time.Time, int, any](Period{End: time.Unix(50, 0)}.Interval(), 2, "s", black)
+				n := newNode[time.Time, int, any](Period{End: time.Unix(45, 0)}.Interval(), 3, "n", black)
+				l := newNode[time.Time, int, any](Period{End: time.Unix(25, 0)}.Interval(), 4, "l", red)
+				r := newNode[time.Time, int, any](Period{End: time.Unix(60, 0)}.Interval(), 5, "r", red)
+				p.left, p.right, p.maxEnd = s, n, r.interval.End
+				s.left, s.right, s.parent, s.maxEnd = l, r, p, r.interval.End
 				l.parent, r.parent = s, s
 				n.parent = p
-				return &PeriodCollection[int, any]{
-					root:  p,
-					nodes: map[int]*node[int, any]{1: p, 2: s, 3: n, 4: l, 5: r},
-				}, n
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: s, 3: n, 4: l, 5: r}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "r", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "s", pc.root.left.contents)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, "p", pc.root.right.contents)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, "l", pc.root.left.left.contents)
-				assert.Equal(t, red, pc.root.left.left.color)
-				assert.True(t, pc.root.right.right.leaf)
-				assert.Equal(t, time.Unix(60, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(50, 0), pc.root.left.maxEnd)
-				assert.Equal(t, time.Unix(25, 0), pc.root.left.left.maxEnd)
-				assert.Equal(t, time.Unix(40, 0), pc.root.right.maxEnd)
-				assert.NotContains(t, pc.nodes, 3)
-				assert.Len(t, pc.nodes, 4)
+				assert.Equal(t, "r", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "s", pc.intervals.root.left.contents)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, "p", pc.intervals.root.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, "l", pc.intervals.root.left.left.contents)
+				assert.Equal(t, red, pc.intervals.root.left.left.color)
+				assert.True(t, pc.intervals.root.right.right.leaf)
+				assert.Equal(t, time.Unix(60, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(50, 0), pc.intervals.root.left.maxEnd)
+				assert.Equal(t, time.Unix(25, 0), pc.intervals.root.left.left.maxEnd)
+				assert.Equal(t, time.Unix(40, 0), pc.intervals.root.right.maxEnd)
+				assert.NotContains(t, pc.intervals.nodes, 3)
+				assert.Len(t, pc.intervals.nodes, 4)
 			},
 		}, {
 			/* P, S, N are black, L, R are red; after deleting N, L is red with the rest black
@@ -592,36 +601,33 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			     L   R            R
 			*/
 			name: "deleting a black left child with no children with a black sibling with red children rebalances the tree",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{End: time.Unix(40, 0)}, 1, "p", black)
-				s := newNode[int, any](Period{End: time.Unix(50, 0)}, 2, "s", black)
-				n := newNode[int, any](Period{End: time.Unix(45, 0)}, 3, "n", black)
-				l := newNode[int, any](Period{End: time.Unix(25, 0)}, 4, "l", red)
-				r := newNode[int, any](Period{End: time.Unix(60, 0)}, 5, "r", red)
-				p.left, p.right, p.maxEnd = n, s, r.period.End
-				s.left, s.right, s.parent, s.maxEnd = l, r, p, r.period.End
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{End: time.Unix(40, 0)}.Interval(), 1, "p", black)
+				s := newNode[time.Time, int, any](Period{End: time.Unix(50, 0)}.Interval(), 2, "s", black)
+				n := newNode[time.Time, int, any](Period{End: time.Unix(45, 0)}.Interval(), 3, "n", black)
+				l := newNode[time.Time, int, any](Period{End: time.Unix(25, 0)}.Interval(), 4, "l", red)
+				r := newNode[time.Time, int, any](Period{End: time.Unix(60, 0)}.Interval(), 5, "r", red)
+				p.left, p.right, p.maxEnd = n, s, r.interval.End
+				s.left, s.right, s.parent, s.maxEnd = l, r, p, r.interval.End
 				l.parent, r.parent = s, s
 				n.parent = p
-				return &PeriodCollection[int, any]{
-					root:  p,
-					nodes: map[int]*node[int, any]{1: p, 2: s, 3: n, 4: l, 5: r},
-				}, n
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: s, 3: n, 4: l, 5: r}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "l", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "p", pc.root.left.contents)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, "s", pc.root.right.contents)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, "r", pc.root.right.right.contents)
-				assert.Equal(t, red, pc.root.right.right.color)
-				assert.Equal(t, time.Unix(60, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(40, 0), pc.root.left.maxEnd)
-				assert.Equal(t, time.Unix(60, 0), pc.root.right.maxEnd)
-				assert.Equal(t, time.Unix(60, 0), pc.root.right.right.maxEnd)
-				assert.NotContains(t, pc.nodes, 3)
-				assert.Len(t, pc.nodes, 4)
+				assert.Equal(t, "l", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "p", pc.intervals.root.left.contents)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, "s", pc.intervals.root.right.contents)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, "r", pc.intervals.root.right.right.contents)
+				assert.Equal(t, red, pc.intervals.root.right.right.color)
+				assert.Equal(t, time.Unix(60, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(40, 0), pc.intervals.root.left.maxEnd)
+				assert.Equal(t, time.Unix(60, 0), pc.intervals.root.right.maxEnd)
+				assert.Equal(t, time.Unix(60, 0), pc.intervals.root.right.right.maxEnd)
+				assert.NotContains(t, pc.intervals.nodes, 3)
+				assert.Len(t, pc.intervals.nodes, 4)
 			},
 		}, {
 			/* P, N, L, R are black, S is red; after deleting N, P is red with the rest black
@@ -632,34 +638,31 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			L   R           R
 			*/
 			name: "deleting a black left node with a red sibling rebalances the tree",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{}, 1, "p", black)
-				s := newNode[int, any](Period{}, 2, "s", red)
-				n := newNode[int, any](Period{}, 3, "n", black)
-				l := newNode[int, any](Period{}, 4, "l", black)
-				r := newNode[int, any](Period{}, 5, "r", black)
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{}.Interval(), 1, "p", black)
+				s := newNode[time.Time, int, any](Period{}.Interval(), 2, "s", red)
+				n := newNode[time.Time, int, any](Period{}.Interval(), 3, "n", black)
+				l := newNode[time.Time, int, any](Period{}.Interval(), 4, "l", black)
+				r := newNode[time.Time, int, any](Period{}.Interval(), 5, "r", black)
 				p.left, p.right = s, n
 				s.left, s.right, s.parent = l, r, p
 				l.parent = s
 				r.parent = s
 				n.parent = p
-				return &PeriodCollection[int, any]{
-					root:  p,
-					nodes: map[int]*node[int, any]{1: p, 2: s, 3: n, 4: l, 5: r},
-				}, n
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: s, 3: n, 4: l, 5: r}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "s", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, "p", pc.root.right.contents)
-				assert.Equal(t, red, pc.root.right.color)
-				assert.Equal(t, "r", pc.root.right.left.contents)
-				assert.Equal(t, black, pc.root.right.left.color)
-				assert.True(t, pc.root.right.right.leaf)
-				assert.NotContains(t, pc.nodes, 3)
-				assert.Len(t, pc.nodes, 4)
+				assert.Equal(t, "s", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, "p", pc.intervals.root.right.contents)
+				assert.Equal(t, red, pc.intervals.root.right.color)
+				assert.Equal(t, "r", pc.intervals.root.right.left.contents)
+				assert.Equal(t, black, pc.intervals.root.right.left.color)
+				assert.True(t, pc.intervals.root.right.right.leaf)
+				assert.NotContains(t, pc.intervals.nodes, 3)
+				assert.Len(t, pc.intervals.nodes, 4)
 			},
 		}, {
 			/* P, N, L, R are black, S is red; after deleting N, P is red with the rest black
@@ -670,34 +673,31 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			  L   R       L
 			*/
 			name: "deleting a black left node with a red sibling rebalances the tree",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{}, 1, "p", black)
-				s := newNode[int, any](Period{}, 2, "s", red)
-				n := newNode[int, any](Period{}, 3, "n", black)
-				l := newNode[int, any](Period{}, 4, "l", black)
-				r := newNode[int, any](Period{}, 5, "r", black)
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{}.Interval(), 1, "p", black)
+				s := newNode[time.Time, int, any](Period{}.Interval(), 2, "s", red)
+				n := newNode[time.Time, int, any](Period{}.Interval(), 3, "n", black)
+				l := newNode[time.Time, int, any](Period{}.Interval(), 4, "l", black)
+				r := newNode[time.Time, int, any](Period{}.Interval(), 5, "r", black)
 				p.left, p.right = n, s
 				s.left, s.right, s.parent = l, r, p
 				l.parent = s
 				r.parent = s
 				n.parent = p
-				return &PeriodCollection[int, any]{
-					root:  p,
-					nodes: map[int]*node[int, any]{1: p, 2: s, 3: n, 4: l, 5: r},
-				}, n
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: s, 3: n, 4: l, 5: r}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "s", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "p", pc.root.left.contents)
-				assert.Equal(t, red, pc.root.left.color)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, "l", pc.root.left.right.contents)
-				assert.Equal(t, black, pc.root.right.left.color)
-				assert.True(t, pc.root.left.left.leaf)
-				assert.NotContains(t, pc.nodes, 3)
-				assert.Len(t, pc.nodes, 4)
+				assert.Equal(t, "s", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "p", pc.intervals.root.left.contents)
+				assert.Equal(t, red, pc.intervals.root.left.color)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, "l", pc.intervals.root.left.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.left.color)
+				assert.True(t, pc.intervals.root.left.left.leaf)
+				assert.NotContains(t, pc.intervals.nodes, 3)
+				assert.Len(t, pc.intervals.nodes, 4)
 			},
 		}, {
 			/* L is red, P, N, S are black to start; after deleting N, all nodes are black
@@ -708,33 +708,30 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			L
 			*/
 			name: "delete left node with left child",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{End: time.Unix(15, 0)}, 1, "p", black)
-				s := newNode[int, any](Period{End: time.Unix(10, 0)}, 2, "s", black)
-				n := newNode[int, any](Period{End: time.Unix(45, 0)}, 3, "n", black)
-				l := newNode[int, any](Period{End: time.Unix(25, 0)}, 4, "l", red)
-				p.left, p.right, p.maxEnd = n, s, n.period.End
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{End: time.Unix(15, 0)}.Interval(), 1, "p", black)
+				s := newNode[time.Time, int, any](Period{End: time.Unix(10, 0)}.Interval(), 2, "s", black)
+				n := newNode[time.Time, int, any](Period{End: time.Unix(45, 0)}.Interval(), 3, "n", black)
+				l := newNode[time.Time, int, any](Period{End: time.Unix(25, 0)}.Interval(), 4, "l", red)
+				p.left, p.right, p.maxEnd = n, s, n.interval.End
 				n.left, n.parent = l, p
 				s.parent = p
 				l.parent = n
-				return &PeriodCollection[int, any]{
-					root:  p,
-					nodes: map[int]*node[int, any]{1: p, 2: s, 3: n, 4: l},
-				}, n
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: s, 3: n, 4: l}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "s", pc.root.right.contents)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, time.Unix(25, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(25, 0), pc.root.left.maxEnd)
-				assert.Equal(t, time.Unix(10, 0), pc.root.right.maxEnd)
-				assert.NotContains(t, pc.nodes, 3)
-				assert.Equal(t, pc.nodes[4], pc.root.left)
-				assert.Len(t, pc.nodes, 3)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "s", pc.intervals.root.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, time.Unix(25, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(25, 0), pc.intervals.root.left.maxEnd)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.right.maxEnd)
+				assert.NotContains(t, pc.intervals.nodes, 3)
+				assert.Equal(t, pc.intervals.nodes[4], pc.intervals.root.left)
+				assert.Len(t, pc.intervals.nodes, 3)
 			},
 		}, {
 			/* R is red, P, N, S are black to start; after deleting N, all nodes are black
@@ -745,32 +742,29 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			      R
 			*/
 			name: "delete right node right left child",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{End: time.Unix(15, 0)}, 1, "p", black)
-				s := newNode[int, any](Period{End: time.Unix(10, 0)}, 2, "s", black)
-				n := newNode[int, any](Period{End: time.Unix(45, 0)}, 3, "n", black)
-				r := newNode[int, any](Period{End: time.Unix(25, 0)}, 4, "r", red)
-				p.left, p.right, p.maxEnd = s, n, n.period.End
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{End: time.Unix(15, 0)}.Interval(), 1, "p", black)
+				s := newNode[time.Time, int, any](Period{End: time.Unix(10, 0)}.Interval(), 2, "s", black)
+				n := newNode[time.Time, int, any](Period{End: time.Unix(45, 0)}.Interval(), 3, "n", black)
+				r := newNode[time.Time, int, any](Period{End: time.Unix(25, 0)}.Interval(), 4, "r", red)
+				p.left, p.right, p.maxEnd = s, n, n.interval.End
 				n.right, n.parent = r, p
 				s.parent = p
 				r.parent = n
-				return &PeriodCollection[int, any]{
-					root:  p,
-					nodes: map[int]*node[int, any]{1: p, 2: s, 3: n, 4: r},
-				}, n
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: s, 3: n, 4: r}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "s", pc.root.left.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, time.Unix(25, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(25, 0), pc.root.right.maxEnd)
-				assert.Equal(t, time.Unix(10, 0), pc.root.left.maxEnd)
-				assert.NotContains(t, pc.nodes, 3)
-				assert.Len(t, pc.nodes, 3)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "s", pc.intervals.root.left.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, time.Unix(25, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(25, 0), pc.intervals.root.right.maxEnd)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.left.maxEnd)
+				assert.NotContains(t, pc.intervals.nodes, 3)
+				assert.Len(t, pc.intervals.nodes, 3)
 			},
 		}, {
 			/* R is red, P, N, S are black to start; after deleting N, all nodes are black
@@ -781,32 +775,29 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			  R
 			*/
 			name: "delete left node with right child",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{End: time.Unix(15, 0)}, 1, "p", black)
-				s := newNode[int, any](Period{End: time.Unix(10, 0)}, 2, "s", black)
-				n := newNode[int, any](Period{End: time.Unix(25, 0)}, 3, "n", black)
-				r := newNode[int, any](Period{End: time.Unix(45, 0)}, 4, "r", red)
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{End: time.Unix(15, 0)}.Interval(), 1, "p", black)
+				s := newNode[time.Time, int, any](Period{End: time.Unix(10, 0)}.Interval(), 2, "s", black)
+				n := newNode[time.Time, int, any](Period{End: time.Unix(25, 0)}.Interval(), 3, "n", black)
+				r := newNode[time.Time, int, any](Period{End: time.Unix(45, 0)}.Interval(), 4, "r", red)
 				p.left, p.right, p.maxEnd = n, s, r.maxEnd
 				n.right, n.parent = r, p
 				s.parent = p
 				r.parent = n
-				return &PeriodCollection[int, any]{
-					root:  p,
-					nodes: map[int]*node[int, any]{1: p, 2: s, 3: n, 4: r},
-				}, n
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: s, 3: n, 4: r}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "r", pc.root.left.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "s", pc.root.right.contents)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, time.Unix(45, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(10, 0), pc.root.right.maxEnd)
-				assert.Equal(t, time.Unix(45, 0), pc.root.left.maxEnd)
-				assert.NotContains(t, pc.nodes, 3)
-				assert.Len(t, pc.nodes, 3)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "r", pc.intervals.root.left.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "s", pc.intervals.root.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, time.Unix(45, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.right.maxEnd)
+				assert.Equal(t, time.Unix(45, 0), pc.intervals.root.left.maxEnd)
+				assert.NotContains(t, pc.intervals.nodes, 3)
+				assert.Len(t, pc.intervals.nodes, 3)
 			},
 		}, {
 			/* R is red, P, N, S are black to start; after deleting N, all nodes are black
@@ -817,48 +808,45 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			  L
 			*/
 			name: "delete right node with left child",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{End: time.Unix(15, 0)}, 1, "p", black)
-				s := newNode[int, any](Period{End: time.Unix(10, 0)}, 2, "s", black)
-				n := newNode[int, any](Period{End: time.Unix(45, 0)}, 3, "n", black)
-				l := newNode[int, any](Period{End: time.Unix(25, 0)}, 4, "l", red)
-				p.left, p.right, p.maxEnd = s, n, n.period.End
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{End: time.Unix(15, 0)}.Interval(), 1, "p", black)
+				s := newNode[time.Time, int, any](Period{End: time.Unix(10, 0)}.Interval(), 2, "s", black)
+				n := newNode[time.Time, int, any](Period{End: time.Unix(45, 0)}.Interval(), 3, "n", black)
+				l := newNode[time.Time, int, any](Period{End: time.Unix(25, 0)}.Interval(), 4, "l", red)
+				p.left, p.right, p.maxEnd = s, n, n.interval.End
 				n.left, n.parent = l, p
 				s.parent = p
 				l.parent = n
-				return &PeriodCollection[int, any]{
-					root:  p,
-					nodes: map[int]*node[int, any]{1: p, 2: s, 3: n, 4: l},
-				}, n
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: s, 3: n, 4: l}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "s", pc.root.left.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "l", pc.root.right.contents)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, time.Unix(25, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(25, 0), pc.root.right.maxEnd)
-				assert.Equal(t, time.Unix(10, 0), pc.root.left.maxEnd)
-				assert.NotContains(t, pc.nodes, 3)
-				assert.Len(t, pc.nodes, 3)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "s", pc.intervals.root.left.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "l", pc.intervals.root.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, time.Unix(25, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(25, 0), pc.intervals.root.right.maxEnd)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.left.maxEnd)
+				assert.NotContains(t, pc.intervals.nodes, 3)
+				assert.Len(t, pc.intervals.nodes, 3)
 			},
 		}, {
 			name: "deleting black node with leaf sibling and red parent makes parent black",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{}, 1, "p", red)
-				n := newNode[int, any](Period{}, 2, "n", black)
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{}.Interval(), 1, "p", red)
+				n := newNode[time.Time, int, any](Period{}.Interval(), 2, "n", black)
 				p.left = n
 				n.parent = p
-				return &PeriodCollection[int, any]{root: p, nodes: map[int]*node[int, any]{1: p, 2: n}}, n
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: n}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.True(t, pc.root.left.leaf)
-				assert.Equal(t, black, pc.root.color)
-				assert.NotContains(t, pc.nodes, 2)
-				assert.Len(t, pc.nodes, 1)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.True(t, pc.intervals.root.left.leaf)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.NotContains(t, pc.intervals.nodes, 2)
+				assert.Len(t, pc.intervals.nodes, 1)
 			},
 		}, {
 			/* contrived example starting with an unbalanced tree:
@@ -870,34 +858,31 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			  L   R    L   R
 			*/
 			name: "deleting a black node with a red sibling with 2 black child nodes rebalances the tree",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{}, 1, "p", black)
-				s := newNode[int, any](Period{}, 2, "s", black)
-				n := newNode[int, any](Period{}, 3, "n", black)
-				l := newNode[int, any](Period{}, 4, "l", black)
-				r := newNode[int, any](Period{}, 5, "r", black)
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{}.Interval(), 1, "p", black)
+				s := newNode[time.Time, int, any](Period{}.Interval(), 2, "s", black)
+				n := newNode[time.Time, int, any](Period{}.Interval(), 3, "n", black)
+				l := newNode[time.Time, int, any](Period{}.Interval(), 4, "l", black)
+				r := newNode[time.Time, int, any](Period{}.Interval(), 5, "r", black)
 				p.left, p.right = n, s
 				s.left, s.right, s.parent = l, r, p
 				l.parent = s
 				r.parent = s
 				n.parent = p
-				return &PeriodCollection[int, any]{
-					root:  p,
-					nodes: map[int]*node[int, any]{1: p, 2: s, 3: n, 4: l, 5: r},
-				}, n
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: s, 3: n, 4: l, 5: r}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "s", pc.root.right.contents)
-				assert.Equal(t, red, pc.root.right.color)
-				assert.Equal(t, "l", pc.root.right.left.contents)
-				assert.Equal(t, black, pc.root.right.left.color)
-				assert.Equal(t, "r", pc.root.right.right.contents)
-				assert.Equal(t, black, pc.root.right.left.color)
-				assert.True(t, pc.root.left.leaf)
-				assert.NotContains(t, pc.nodes, 3)
-				assert.Len(t, pc.nodes, 4)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "s", pc.intervals.root.right.contents)
+				assert.Equal(t, red, pc.intervals.root.right.color)
+				assert.Equal(t, "l", pc.intervals.root.right.left.contents)
+				assert.Equal(t, black, pc.intervals.root.right.left.color)
+				assert.Equal(t, "r", pc.intervals.root.right.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.left.color)
+				assert.True(t, pc.intervals.root.left.leaf)
+				assert.NotContains(t, pc.intervals.nodes, 3)
+				assert.Len(t, pc.intervals.nodes, 4)
 			},
 		},
 		{
@@ -909,35 +894,32 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			  RL
 			*/
 			name: "delete an internal node with a black successor",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				n := newNode[int, any](Period{End: time.Unix(20, 0)}, 1, "n", black)
-				l := newNode[int, any](Period{End: time.Unix(10, 0)}, 2, "l", black)
-				r := newNode[int, any](Period{End: time.Unix(30, 0)}, 3, "r", black)
-				rl := newNode[int, any](Period{End: time.Unix(50, 0)}, 4, "rl", red)
-				n.left, n.right, n.maxEnd = l, r, rl.period.End
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				n := newNode[time.Time, int, any](Period{End: time.Unix(20, 0)}.Interval(), 1, "n", black)
+				l := newNode[time.Time, int, any](Period{End: time.Unix(10, 0)}.Interval(), 2, "l", black)
+				r := newNode[time.Time, int, any](Period{End: time.Unix(30, 0)}.Interval(), 3, "r", black)
+				rl := newNode[time.Time, int, any](Period{End: time.Unix(50, 0)}.Interval(), 4, "rl", red)
+				n.left, n.right, n.maxEnd = l, r, rl.interval.End
 				r.left, r.parent = rl, n
 				l.parent = n
 				rl.parent = r
-				return &PeriodCollection[int, any]{
-					root:  n,
-					nodes: map[int]*node[int, any]{1: n, 2: l, 3: r, 4: rl},
-				}, n
+				return periodCollectionOf[int, any](n, map[int]*node[time.Time, int, any]{1: n, 2: l, 3: r, 4: rl}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "rl", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, time.Unix(50, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(10, 0), pc.root.left.maxEnd)
-				assert.Equal(t, time.Unix(30, 0), pc.root.right.maxEnd)
-				assert.NotContains(t, pc.nodes, 1)
-				assert.Len(t, pc.nodes, 3)
-				assert.Equal(t, pc.nodes[4], pc.root)
-				assert.Equal(t, pc.nodes[2], pc.root.left)
-				assert.Equal(t, pc.nodes[3], pc.root.right)
+				assert.Equal(t, "rl", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, time.Unix(50, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.left.maxEnd)
+				assert.Equal(t, time.Unix(30, 0), pc.intervals.root.right.maxEnd)
+				assert.NotContains(t, pc.intervals.nodes, 1)
+				assert.Len(t, pc.intervals.nodes, 3)
+				assert.Equal(t, pc.intervals.nodes[4], pc.intervals.root)
+				assert.Equal(t, pc.intervals.nodes[2], pc.intervals.root.left)
+				assert.Equal(t, pc.intervals.nodes[3], pc.intervals.root.right)
 			},
 		},
 		{
@@ -949,55 +931,49 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			  RL
 			*/
 			name: "delete an internal node with a black successor with max end less than the internal node",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				n := newNode[int, any](Period{End: time.Unix(50, 0)}, 1, "n", black)
-				l := newNode[int, any](Period{End: time.Unix(10, 0)}, 2, "l", black)
-				r := newNode[int, any](Period{End: time.Unix(30, 0)}, 3, "r", black)
-				rl := newNode[int, any](Period{End: time.Unix(20, 0)}, 4, "rl", red)
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				n := newNode[time.Time, int, any](Period{End: time.Unix(50, 0)}.Interval(), 1, "n", black)
+				l := newNode[time.Time, int, any](Period{End: time.Unix(10, 0)}.Interval(), 2, "l", black)
+				r := newNode[time.Time, int, any](Period{End: time.Unix(30, 0)}.Interval(), 3, "r", black)
+				rl := newNode[time.Time, int, any](Period{End: time.Unix(20, 0)}.Interval(), 4, "rl", red)
 				n.left, n.right = l, r
-				r.left, r.parent, r.maxEnd = rl, n, rl.period.End
+				r.left, r.parent, r.maxEnd = rl, n, rl.interval.End
 				l.parent = n
 				rl.parent = r
-				return &PeriodCollection[int, any]{
-					root:  n,
-					nodes: map[int]*node[int, any]{1: n, 2: l, 3: r, 4: rl},
-				}, n
+				return periodCollectionOf[int, any](n, map[int]*node[time.Time, int, any]{1: n, 2: l, 3: r, 4: rl}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "rl", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, time.Unix(30, 0), pc.root.maxEnd)
-				assert.Equal(t, time.Unix(10, 0), pc.root.left.maxEnd)
-				assert.Equal(t, time.Unix(30, 0), pc.root.right.maxEnd)
-				assert.NotContains(t, pc.nodes, 1)
-				assert.Len(t, pc.nodes, 3)
-				assert.Equal(t, pc.nodes[4], pc.root)
-				assert.Equal(t, pc.nodes[2], pc.root.left)
-				assert.Equal(t, pc.nodes[3], pc.root.right)
+				assert.Equal(t, "rl", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, time.Unix(30, 0), pc.intervals.root.maxEnd)
+				assert.Equal(t, time.Unix(10, 0), pc.intervals.root.left.maxEnd)
+				assert.Equal(t, time.Unix(30, 0), pc.intervals.root.right.maxEnd)
+				assert.NotContains(t, pc.intervals.nodes, 1)
+				assert.Len(t, pc.intervals.nodes, 3)
+				assert.Equal(t, pc.intervals.nodes[4], pc.intervals.root)
+				assert.Equal(t, pc.intervals.nodes[2], pc.intervals.root.left)
+				assert.Equal(t, pc.intervals.nodes[3], pc.intervals.root.right)
 			},
 		}, {
 			name: "deleting the only child of the root updates max end correctly",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				root := newNode[int, any](Period{End: time.Unix(20, 0)}, 1, "root", black)
-				r := newNode[int, any](Period{End: time.Unix(30, 0)}, 2, "r", black)
-				root.right, root.maxEnd = r, r.period.End
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				root := newNode[time.Time, int, any](Period{End: time.Unix(20, 0)}.Interval(), 1, "root", black)
+				r := newNode[time.Time, int, any](Period{End: time.Unix(30, 0)}.Interval(), 2, "r", black)
+				root.right, root.maxEnd = r, r.interval.End
 				r.parent = root
-				return &PeriodCollection[int, any]{
-					root:  root,
-					nodes: map[int]*node[int, any]{1: root, 2: r},
-				}, r
+				return periodCollectionOf[int, any](root, map[int]*node[time.Time, int, any]{1: root, 2: r}), r
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "root", pc.root.contents)
-				assert.True(t, pc.root.left.leaf)
-				assert.True(t, pc.root.right.leaf)
-				assert.Equal(t, time.Unix(20, 0), pc.root.maxEnd)
-				assert.NotContains(t, pc.nodes, 2)
-				assert.Len(t, pc.nodes, 1)
+				assert.Equal(t, "root", pc.intervals.root.contents)
+				assert.True(t, pc.intervals.root.left.leaf)
+				assert.True(t, pc.intervals.root.right.leaf)
+				assert.Equal(t, time.Unix(20, 0), pc.intervals.root.maxEnd)
+				assert.NotContains(t, pc.intervals.nodes, 2)
+				assert.Len(t, pc.intervals.nodes, 1)
 			},
 		}, {
 			/* P, L, and R are black, N is red; N has the earliest start and latest end;
@@ -1009,39 +985,36 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 			N
 			*/
 			name: "maxend property correctly propagates up the tree",
-			setupTree: func() (*PeriodCollection[int, any], *node[int, any]) {
-				p := newNode[int, any](Period{Start: time.Unix(30, 0), End: time.Unix(40, 0)}, 1, "p", black)
-				l := newNode[int, any](Period{Start: time.Unix(20, 0), End: time.Unix(25, 0)}, 2, "l", black)
-				r := newNode[int, any](Period{Start: time.Unix(50, 0), End: time.Unix(60, 0)}, 3, "r", black)
-				n := newNode[int, any](Period{Start: time.Unix(10, 0), End: time.Unix(70, 0)}, 4, "n", red)
-				p.left, p.right, p.maxEnd = l, r, n.period.End
-				l.left, l.parent, l.maxEnd = n, p, n.period.End
-				r.parent, r.maxEnd = p, r.period.End
-				n.parent, n.maxEnd = l, n.period.End
-				return &PeriodCollection[int, any]{
-					root:  p,
-					nodes: map[int]*node[int, any]{1: p, 2: l, 3: r, 4: n},
-				}, n
+			setupTree: func() (*PeriodCollection[int, any], *node[time.Time, int, any]) {
+				p := newNode[time.Time, int, any](Period{Start: time.Unix(30, 0), End: time.Unix(40, 0)}.Interval(), 1, "p", black)
+				l := newNode[time.Time, int, any](Period{Start: time.Unix(20, 0), End: time.Unix(25, 0)}.Interval(), 2, "l", black)
+				r := newNode[time.Time, int, any](Period{Start: time.Unix(50, 0), End: time.Unix(60, 0)}.Interval(), 3, "r", black)
+				n := newNode[time.Time, int, any](Period{Start: time.Unix(10, 0), End: time.Unix(70, 0)}.Interval(), 4, "n", red)
+				p.left, p.right, p.maxEnd = l, r, n.interval.End
+				l.left, l.parent, l.maxEnd = n, p, n.interval.End
+				r.parent, r.maxEnd = p, r.interval.End
+				n.parent, n.maxEnd = l, n.interval.End
+				return periodCollectionOf[int, any](p, map[int]*node[time.Time, int, any]{1: p, 2: l, 3: r, 4: n}), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, black, pc.root.color)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.True(t, pc.root.left.left.leaf)
-				assert.Equal(t, time.Unix(25, 0), pc.root.left.maxEnd)
-				assert.Equal(t, time.Unix(60, 0), pc.root.maxEnd)
-				assert.NotContains(t, pc.nodes, 4)
-				assert.Len(t, pc.nodes, 3)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, black, pc.intervals.root.color)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.True(t, pc.intervals.root.left.left.leaf)
+				assert.Equal(t, time.Unix(25, 0), pc.intervals.root.left.maxEnd)
+				assert.Equal(t, time.Unix(60, 0), pc.intervals.root.maxEnd)
+				assert.NotContains(t, pc.intervals.nodes, 4)
+				assert.Len(t, pc.intervals.nodes, 3)
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			pc, nodeToDelete := test.setupTree()
-			pc.deleteNode(nodeToDelete)
+			pc.intervals.deleteNode(nodeToDelete)
 			test.validate(t, pc)
 		})
 	}
@@ -1049,7 +1022,7 @@ func TestPeriodCollection_deleteNode(t *testing.T) {
 
 func TestPeriodCollection_deleteRepairCase1(t *testing.T) {
 	tests := []struct {
-		setup    func() (*PeriodCollection[int, string], *node[int, string])
+		setup    func() (*PeriodCollection[int, string], *node[time.Time, int, string])
 		validate func(t *testing.T, pc *PeriodCollection[int, string])
 		name     string
 	}{
@@ -1062,12 +1035,12 @@ func TestPeriodCollection_deleteRepairCase1(t *testing.T) {
 			L   R                  R   N (leaf)
 			*/
 			name: "deleted right child with red sibling rotates right around the parent",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				s := newNode[int, string](Period{}, 0, "s", red)
-				l := newNode[int, string](Period{}, 0, "l", black)
-				r := newNode[int, string](Period{}, 0, "r", black)
-				n := &node[int, string]{leaf: true}
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				s := newNode[time.Time, int, string](Period{}.Interval(), 0, "s", red)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
+				n := &node[time.Time, int, string]{leaf: true}
 				p.left = s
 				p.right = n
 				s.left = l
@@ -1077,14 +1050,14 @@ func TestPeriodCollection_deleteRepairCase1(t *testing.T) {
 				l.parent = s
 				r.parent = s
 				n.parent = p
-				return &PeriodCollection[int, string]{root: p}, n
+				return periodCollectionOf[int, string](p, nil), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "s", pc.root.contents)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, "p", pc.root.right.contents)
-				assert.Equal(t, "r", pc.root.right.left.contents)
-				assert.True(t, pc.root.right.right.leaf)
+				assert.Equal(t, "s", pc.intervals.root.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, "p", pc.intervals.root.right.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.left.contents)
+				assert.True(t, pc.intervals.root.right.right.leaf)
 			},
 		}, {
 			/* N is deleted; S is red to start, everything else is black
@@ -1095,12 +1068,12 @@ func TestPeriodCollection_deleteRepairCase1(t *testing.T) {
 			         L   R   (leaf) N   L
 			*/
 			name: "deleted left child with red sibling rotates left around the parent",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				s := newNode[int, string](Period{}, 0, "s", red)
-				l := newNode[int, string](Period{}, 0, "l", black)
-				r := newNode[int, string](Period{}, 0, "r", black)
-				n := &node[int, string]{leaf: true}
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				s := newNode[time.Time, int, string](Period{}.Interval(), 0, "s", red)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
+				n := &node[time.Time, int, string]{leaf: true}
 				p.right = s
 				p.left = n
 				s.left = l
@@ -1110,52 +1083,52 @@ func TestPeriodCollection_deleteRepairCase1(t *testing.T) {
 				l.parent = s
 				r.parent = s
 				n.parent = p
-				return &PeriodCollection[int, string]{root: p}, n
+				return periodCollectionOf[int, string](p, nil), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "s", pc.root.contents)
-				assert.Equal(t, "p", pc.root.left.contents)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, "l", pc.root.left.right.contents)
-				assert.True(t, pc.root.left.left.leaf)
+				assert.Equal(t, "s", pc.intervals.root.contents)
+				assert.Equal(t, "p", pc.intervals.root.left.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.right.contents)
+				assert.True(t, pc.intervals.root.left.left.leaf)
 			},
 		}, {
 			name: "deleted child with black sibling does nothing",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				s := newNode[int, string](Period{}, 0, "s", black)
-				n := &node[int, string]{leaf: true}
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				s := newNode[time.Time, int, string](Period{}.Interval(), 0, "s", black)
+				n := &node[time.Time, int, string]{leaf: true}
 				p.left = s
 				p.right = n
 				n.parent = p
 				s.parent = p
-				return &PeriodCollection[int, string]{root: p}, n
+				return periodCollectionOf[int, string](p, nil), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "s", pc.root.left.contents)
-				assert.True(t, pc.root.right.leaf)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "s", pc.intervals.root.left.contents)
+				assert.True(t, pc.intervals.root.right.leaf)
 			},
 		}, {
 			name: "deleted child with no sibling does nothing",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				n := &node[int, string]{leaf: true}
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				n := &node[time.Time, int, string]{leaf: true}
 				p.right = n
 				n.parent = p
-				return &PeriodCollection[int, string]{root: p}, n
+				return periodCollectionOf[int, string](p, nil), n
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.True(t, pc.root.left.leaf)
-				assert.True(t, pc.root.right.leaf)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.True(t, pc.intervals.root.left.leaf)
+				assert.True(t, pc.intervals.root.right.leaf)
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			pc, n := test.setup()
-			pc.deleteRepairCase1(n)
+			pc.intervals.deleteRepairCase1(n)
 			test.validate(t, pc)
 		})
 	}
@@ -1163,65 +1136,65 @@ func TestPeriodCollection_deleteRepairCase1(t *testing.T) {
 
 func TestPeriodCollection_deleteRepairCase2(t *testing.T) {
 	tests := []struct {
-		setup           func() (*PeriodCollection[int, string], *node[int, string], *node[int, string])
+		setup           func() (*PeriodCollection[int, string], *node[time.Time, int, string], *node[time.Time, int, string])
 		name            string
 		expectedOutcome bool
 		expectRecolor   bool
 	}{
 		{
 			name: "deleted node with black sibling with 2 black child nodes recolors the sibling and returns true",
-			setup: func() (*PeriodCollection[int, string], *node[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				s := newNode[int, string](Period{}, 0, "s", black)
-				sl := newNode[int, string](Period{}, 0, "sl", black)
-				sr := newNode[int, string](Period{}, 0, "sr", black)
-				n := &node[int, string]{leaf: true, contents: "n"}
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				s := newNode[time.Time, int, string](Period{}.Interval(), 0, "s", black)
+				sl := newNode[time.Time, int, string](Period{}.Interval(), 0, "sl", black)
+				sr := newNode[time.Time, int, string](Period{}.Interval(), 0, "sr", black)
+				n := &node[time.Time, int, string]{leaf: true, contents: "n"}
 				p.left, p.right = s, n
 				s.left, s.right, s.parent = sl, sr, p
 				sl.parent, sr.parent = s, s
 				n.parent = p
-				return &PeriodCollection[int, string]{root: p}, n, s
+				return periodCollectionOf[int, string](p, nil), n, s
 			},
 			expectedOutcome: true,
 			expectRecolor:   true,
 		}, {
 			name: "deleted node with black sibling and 1 child does nothing",
-			setup: func() (*PeriodCollection[int, string], *node[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "", black)
-				s := newNode[int, string](Period{}, 0, "", black)
-				sr := newNode[int, string](Period{}, 0, "", black)
-				n := &node[int, string]{leaf: true}
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "", black)
+				s := newNode[time.Time, int, string](Period{}.Interval(), 0, "", black)
+				sr := newNode[time.Time, int, string](Period{}.Interval(), 0, "", black)
+				n := &node[time.Time, int, string]{leaf: true}
 				p.left, p.right = s, n
 				s.right, s.parent = sr, p
 				sr.parent = s
 				n.parent = p
-				return &PeriodCollection[int, string]{root: p}, n, s
+				return periodCollectionOf[int, string](p, nil), n, s
 			},
 		}, {
 			name: "deleted node with leaf sibling returns true but does not recolor the leaf",
-			setup: func() (*PeriodCollection[int, string], *node[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "", black)
-				s := &node[int, string]{leaf: true}
-				n := &node[int, string]{leaf: true}
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "", black)
+				s := &node[time.Time, int, string]{leaf: true}
+				n := &node[time.Time, int, string]{leaf: true}
 				p.left, p.right = s, n
 				s.parent = p
 				n.parent = p
-				return &PeriodCollection[int, string]{root: p}, n, s
+				return periodCollectionOf[int, string](p, nil), n, s
 			},
 			expectedOutcome: true,
 		}, {
 			name: "deleted node with red sibling does nothing",
-			setup: func() (*PeriodCollection[int, string], *node[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "", black)
-				s := newNode[int, string](Period{}, 0, "", red)
-				sl := newNode[int, string](Period{}, 0, "", black)
-				sr := newNode[int, string](Period{}, 0, "", black)
-				n := &node[int, string]{leaf: true}
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "", black)
+				s := newNode[time.Time, int, string](Period{}.Interval(), 0, "", red)
+				sl := newNode[time.Time, int, string](Period{}.Interval(), 0, "", black)
+				sr := newNode[time.Time, int, string](Period{}.Interval(), 0, "", black)
+				n := &node[time.Time, int, string]{leaf: true}
 				p.left, p.right = s, n
 				s.left, s.right, s.parent = sl, sr, p
 				sl.parent, sr.parent = s, s
 				n.parent = p
-				return &PeriodCollection[int, string]{root: p}, n, s
+				return periodCollectionOf[int, string](p, nil), n, s
 			},
 		},
 	}
@@ -1229,7 +1202,7 @@ func TestPeriodCollection_deleteRepairCase2(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			pc, n, s := test.setup()
 			sColorBefore := s.color
-			result := pc.deleteRepairCase2(n)
+			result := pc.intervals.deleteRepairCase2(n)
 			assert.Equal(t, test.expectedOutcome, result)
 			if test.expectRecolor {
 				assert.Equal(t, red, s.color)
@@ -1242,125 +1215,125 @@ func TestPeriodCollection_deleteRepairCase2(t *testing.T) {
 
 func TestPeriodCollection_deleteRepairCase3(t *testing.T) {
 	tests := []struct {
-		setup    func() (*PeriodCollection[int, string], *node[int, string])
+		setup    func() (*PeriodCollection[int, string], *node[time.Time, int, string])
 		validate func(t *testing.T, pc *PeriodCollection[int, string])
 		name     string
 	}{
 		{
 			name: "no action when sibling is a leaf",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				r := newNode[int, string](Period{}, 0, "r", black)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
 				r.parent = p
 				p.right = r
-				return &PeriodCollection[int, string]{root: p}, r
+				return periodCollectionOf[int, string](p, nil), r
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.True(t, pc.root.left.leaf)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.True(t, pc.intervals.root.left.leaf)
 			},
 		}, {
 			name: "no action when sibling is red",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				r := newNode[int, string](Period{}, 0, "r", red)
-				l := newNode[int, string](Period{}, 0, "l", red)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", red)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", red)
 				l.parent, r.parent = p, p
 				p.left, p.right = l, r
-				return &PeriodCollection[int, string]{root: p}, r
+				return periodCollectionOf[int, string](p, nil), r
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, "r", pc.root.right.contents)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
 			},
 		}, {
 			name: "no action when node is right child and sibling has no right child",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				r := newNode[int, string](Period{}, 0, "r", black)
-				l := newNode[int, string](Period{}, 0, "l", black)
-				ll := newNode[int, string](Period{}, 0, "ll", red)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", black)
+				ll := newNode[time.Time, int, string](Period{}.Interval(), 0, "ll", red)
 				l.parent, r.parent = p, p
 				p.left, p.right = l, r
 				l.left = ll
 				ll.parent = ll
-				return &PeriodCollection[int, string]{root: p}, r
+				return periodCollectionOf[int, string](p, nil), r
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, "ll", pc.root.left.left.contents)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, "ll", pc.intervals.root.left.left.contents)
 			},
 		}, {
 			name: "no action when node is left child and sibling has no left child",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				r := newNode[int, string](Period{}, 0, "r", black)
-				l := newNode[int, string](Period{}, 0, "l", black)
-				ll := newNode[int, string](Period{}, 0, "ll", red)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", black)
+				ll := newNode[time.Time, int, string](Period{}.Interval(), 0, "ll", red)
 				l.parent, r.parent = p, p
 				p.left, p.right = l, r
 				l.left = ll
 				ll.parent = ll
-				return &PeriodCollection[int, string]{root: p}, l
+				return periodCollectionOf[int, string](p, nil), l
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, "ll", pc.root.left.left.contents)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, "ll", pc.intervals.root.left.left.contents)
 			},
 		}, {
 			name: "left rotate around sibling and recolor when node is right child and sibling is black with red right child",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				r := newNode[int, string](Period{}, 0, "r", black)
-				l := newNode[int, string](Period{}, 0, "l", black)
-				lr := newNode[int, string](Period{}, 0, "lr", red)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", black)
+				lr := newNode[time.Time, int, string](Period{}.Interval(), 0, "lr", red)
 				l.parent, r.parent = p, p
 				p.left, p.right = l, r
 				l.right = lr
 				lr.parent = l
-				return &PeriodCollection[int, string]{root: p}, r
+				return periodCollectionOf[int, string](p, nil), r
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "lr", pc.root.left.contents)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, "l", pc.root.left.left.contents)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, red, pc.root.left.left.color)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "lr", pc.intervals.root.left.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.left.contents)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, red, pc.intervals.root.left.left.color)
 			},
 		}, {
 			name: "right rotate around sibling and recolor when node is left child and sibling is black with red left child",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				r := newNode[int, string](Period{}, 0, "r", black)
-				l := newNode[int, string](Period{}, 0, "l", black)
-				rl := newNode[int, string](Period{}, 0, "rl", red)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", black)
+				rl := newNode[time.Time, int, string](Period{}.Interval(), 0, "rl", red)
 				l.parent, r.parent = p, p
 				p.left, p.right = l, r
 				r.left = rl
 				rl.parent = r
-				return &PeriodCollection[int, string]{root: p}, l
+				return periodCollectionOf[int, string](p, nil), l
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "rl", pc.root.right.contents)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, "r", pc.root.right.right.contents)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, red, pc.root.right.right.color)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "rl", pc.intervals.root.right.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.right.contents)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, red, pc.intervals.root.right.right.color)
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			pc, n := test.setup()
-			pc.deleteRepairCase3(n)
+			pc.intervals.deleteRepairCase3(n)
 			test.validate(t, pc)
 		})
 	}
@@ -1368,127 +1341,127 @@ func TestPeriodCollection_deleteRepairCase3(t *testing.T) {
 
 func TestPeriodCollection_deleteRepairCase4(t *testing.T) {
 	tests := []struct {
-		setup    func() (*PeriodCollection[int, string], *node[int, string])
+		setup    func() (*PeriodCollection[int, string], *node[time.Time, int, string])
 		validate func(t *testing.T, pc *PeriodCollection[int, string])
 		name     string
 	}{
 		{
 			name: "no action when sibling is a leaf",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				r := newNode[int, string](Period{}, 0, "r", black)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
 				r.parent = p
 				p.right = r
-				return &PeriodCollection[int, string]{root: p}, r
+				return periodCollectionOf[int, string](p, nil), r
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.True(t, pc.root.left.leaf)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.True(t, pc.intervals.root.left.leaf)
 			},
 		}, {
 			name: "no action when sibling is red",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				r := newNode[int, string](Period{}, 0, "r", red)
-				l := newNode[int, string](Period{}, 0, "l", red)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", red)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", red)
 				l.parent, r.parent = p, p
 				p.left, p.right = l, r
-				return &PeriodCollection[int, string]{root: p}, r
+				return periodCollectionOf[int, string](p, nil), r
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, "r", pc.root.right.contents)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
 			},
 		}, {
 			name: "no action when right child and sibling has no left child",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				r := newNode[int, string](Period{}, 0, "r", black)
-				l := newNode[int, string](Period{}, 0, "l", black)
-				lr := newNode[int, string](Period{}, 0, "lr", red)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", black)
+				lr := newNode[time.Time, int, string](Period{}.Interval(), 0, "lr", red)
 				l.parent, r.parent = p, p
 				p.left, p.right = l, r
 				l.right = lr
 				lr.parent = l
-				return &PeriodCollection[int, string]{root: p}, r
+				return periodCollectionOf[int, string](p, nil), r
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, "lr", pc.root.left.right.contents)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, "lr", pc.intervals.root.left.right.contents)
 			},
 		}, {
 			name: "no action when left child and sibling has no right child",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", black)
-				r := newNode[int, string](Period{}, 0, "r", black)
-				l := newNode[int, string](Period{}, 0, "l", black)
-				rl := newNode[int, string](Period{}, 0, "rl", red)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", black)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", black)
+				rl := newNode[time.Time, int, string](Period{}.Interval(), 0, "rl", red)
 				l.parent, r.parent = p, p
 				p.left, p.right = l, r
 				r.left = rl
 				rl.parent = r
-				return &PeriodCollection[int, string]{root: p}, r
+				return periodCollectionOf[int, string](p, nil), r
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "p", pc.root.contents)
-				assert.Equal(t, "l", pc.root.left.contents)
-				assert.Equal(t, "r", pc.root.right.contents)
-				assert.Equal(t, "rl", pc.root.right.left.contents)
+				assert.Equal(t, "p", pc.intervals.root.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.contents)
+				assert.Equal(t, "rl", pc.intervals.root.right.left.contents)
 			},
 		}, {
 			name: "right rotate around parent and recolor when right child and sibling is black with red left child",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", red)
-				r := newNode[int, string](Period{}, 0, "r", black)
-				l := newNode[int, string](Period{}, 0, "l", black)
-				ll := newNode[int, string](Period{}, 0, "ll", red)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", red)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", black)
+				ll := newNode[time.Time, int, string](Period{}.Interval(), 0, "ll", red)
 				l.parent, r.parent = p, p
 				p.left, p.right = l, r
 				l.left = ll
 				ll.parent = l
-				return &PeriodCollection[int, string]{root: p}, r
+				return periodCollectionOf[int, string](p, nil), r
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "l", pc.root.contents)
-				assert.Equal(t, "ll", pc.root.left.contents)
-				assert.Equal(t, "p", pc.root.right.contents)
-				assert.Equal(t, "r", pc.root.right.right.contents)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, red, pc.root.color)
+				assert.Equal(t, "l", pc.intervals.root.contents)
+				assert.Equal(t, "ll", pc.intervals.root.left.contents)
+				assert.Equal(t, "p", pc.intervals.root.right.contents)
+				assert.Equal(t, "r", pc.intervals.root.right.right.contents)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, red, pc.intervals.root.color)
 			},
 		}, {
 			name: "left rotate around parent and recolor when left child and sibling is black with red right child",
-			setup: func() (*PeriodCollection[int, string], *node[int, string]) {
-				p := newNode[int, string](Period{}, 0, "p", red)
-				r := newNode[int, string](Period{}, 0, "r", black)
-				l := newNode[int, string](Period{}, 0, "l", black)
-				rr := newNode[int, string](Period{}, 0, "rr", red)
+			setup: func() (*PeriodCollection[int, string], *node[time.Time, int, string]) {
+				p := newNode[time.Time, int, string](Period{}.Interval(), 0, "p", red)
+				r := newNode[time.Time, int, string](Period{}.Interval(), 0, "r", black)
+				l := newNode[time.Time, int, string](Period{}.Interval(), 0, "l", black)
+				rr := newNode[time.Time, int, string](Period{}.Interval(), 0, "rr", red)
 				l.parent, r.parent = p, p
 				p.left, p.right = l, r
 				r.right = rr
 				rr.parent = r
-				return &PeriodCollection[int, string]{root: p}, l
+				return periodCollectionOf[int, string](p, nil), l
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, string]) {
-				assert.Equal(t, "r", pc.root.contents)
-				assert.Equal(t, "p", pc.root.left.contents)
-				assert.Equal(t, "rr", pc.root.right.contents)
-				assert.Equal(t, "l", pc.root.left.left.contents)
-				assert.Equal(t, black, pc.root.left.color)
-				assert.Equal(t, black, pc.root.right.color)
-				assert.Equal(t, red, pc.root.color)
+				assert.Equal(t, "r", pc.intervals.root.contents)
+				assert.Equal(t, "p", pc.intervals.root.left.contents)
+				assert.Equal(t, "rr", pc.intervals.root.right.contents)
+				assert.Equal(t, "l", pc.intervals.root.left.left.contents)
+				assert.Equal(t, black, pc.intervals.root.left.color)
+				assert.Equal(t, black, pc.intervals.root.right.color)
+				assert.Equal(t, red, pc.intervals.root.color)
 			},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			pc, n := test.setup()
-			pc.deleteRepairCase4(n)
+			pc.intervals.deleteRepairCase4(n)
 			test.validate(t, pc)
 		})
 	}
@@ -1616,6 +1589,16 @@ func TestPeriodCollection_ContainsTime(t *testing.T) {
 			},
 			time.Date(2018, 12, 4, 0, 0, 0, 0, time.UTC),
 			[]any{},
+		}, {
+			"2018-12-07 00:00 contained in closed period ending at 2018-12-07 00:00 in left subtree",
+			func() *PeriodCollection[int, any] {
+				closed := NewPeriodCollection[int, any]()
+				require.NoError(t, closed.Insert(0, nodes[1].period, "b"))
+				require.NoError(t, closed.Insert(1, nodes[0].period.WithBounds(ClosedClosed), "a"))
+				return closed
+			},
+			time.Date(2018, 12, 7, 0, 0, 0, 0, time.UTC),
+			[]any{"a", "b"},
 		},
 	}
 	for _, test := range tests {
@@ -1745,18 +1728,15 @@ func TestPeriodCollection_Intersecting(t *testing.T) {
 		{
 			"2018-12-9 12:00 - 2018-12-28 14:00 intersects periods including in order successor of root",
 			func() *PeriodCollection[int, any] {
-				n := newNode[int, any](Period{time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 4, 0, 0, 0, 0, time.UTC)}, 1, "n", black)
-				l := newNode[int, any](Period{time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 2, 0, 0, 0, 0, time.UTC)}, 2, "l", black)
-				r := newNode[int, any](Period{time.Date(2019, 12, 5, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 6, 0, 0, 0, 0, time.UTC)}, 3, "r", black)
-				rl := newNode[int, any](Period{time.Date(2019, 12, 3, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 4, 0, 0, 0, 0, time.UTC)}, 4, "rl", red)
+				n := newNode[time.Time, int, any](NewPeriod(time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 4, 0, 0, 0, 0, time.UTC)).Interval(), 1, "n", black)
+				l := newNode[time.Time, int, any](NewPeriod(time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 2, 0, 0, 0, 0, time.UTC)).Interval(), 2, "l", black)
+				r := newNode[time.Time, int, any](NewPeriod(time.Date(2019, 12, 5, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 6, 0, 0, 0, 0, time.UTC)).Interval(), 3, "r", black)
+				rl := newNode[time.Time, int, any](NewPeriod(time.Date(2019, 12, 3, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 4, 0, 0, 0, 0, time.UTC)).Interval(), 4, "rl", red)
 				n.left, n.right = l, r
-				r.left, r.parent, r.maxEnd = rl, n, rl.period.End
+				r.left, r.parent, r.maxEnd = rl, n, rl.interval.End
 				l.parent = n
 				rl.parent = r
-				return &PeriodCollection[int, any]{
-					root:  n,
-					nodes: map[int]*node[int, any]{1: n, 2: l, 3: r, 4: rl},
-				}
+				return periodCollectionOf[int, any](n, map[int]*node[time.Time, int, any]{1: n, 2: l, 3: r, 4: rl})
 			},
 			NewPeriod(time.Date(2019, 12, 3, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 4, 0, 0, 0, 0, time.UTC)),
 			[]any{"n", "rl"},
@@ -1771,11 +1751,9 @@ func TestPeriodCollection_Intersecting(t *testing.T) {
 }
 
 func TestPeriodCollection_ContainsKey(t *testing.T) {
-	pc := PeriodCollection[int, any]{
-		nodes: map[int]*node[int, any]{
-			1: {},
-		},
-	}
+	pc := periodCollectionOf[int, any](nil, map[int]*node[time.Time, int, any]{
+		1: {},
+	})
 	tests := []struct {
 		name    string
 		k       int
@@ -1813,42 +1791,43 @@ func TestPeriodCollection_Update(t *testing.T) {
 			updateKey:   1,
 			newContents: 1,
 			validate: func(t *testing.T, pc *PeriodCollection[int, int]) {
-				assert.Len(t, pc.nodes, 1)
-				assert.Equal(t, 1, pc.root.key)
-				assert.True(t, pc.root.left.leaf)
-				assert.True(t, pc.root.right.leaf)
+				assert.Len(t, pc.intervals.nodes, 1)
+				assert.Equal(t, 1, pc.intervals.root.key)
+				assert.True(t, pc.intervals.root.left.leaf)
+				assert.True(t, pc.intervals.root.right.leaf)
 			},
 		}, {
 			name: "updating contents without updating the period swaps contents",
 			setup: func() *PeriodCollection[int, int] {
 				pc := NewPeriodCollection[int, int]()
-				l := &node[int, int]{contents: 1}
-				pc.root = &node[int, int]{
-					left: l,
+				l := &node[time.Time, int, int]{interval: Period{}.Interval(), contents: 1}
+				pc.intervals.root = &node[time.Time, int, int]{
+					interval: Period{}.Interval(),
+					left:     l,
 				}
-				pc.nodes[0] = pc.root
-				pc.nodes[1] = l
+				pc.intervals.nodes[0] = pc.intervals.root
+				pc.intervals.nodes[1] = l
 				return pc
 			},
 			updateKey:   1,
 			newContents: 2,
 			validate: func(t *testing.T, pc *PeriodCollection[int, int]) {
-				l, ok := pc.nodes[1]
+				l, ok := pc.intervals.nodes[1]
 				require.True(t, ok)
-				require.Equal(t, l, pc.root.left)
+				require.Equal(t, l, pc.intervals.root.left)
 				assert.Equal(t, l.contents, 2)
-				assert.Len(t, pc.nodes, 2)
+				assert.Len(t, pc.intervals.nodes, 2)
 			},
 		}, {
 			name: "updating the period deletes and reinserts the node",
 			setup: func() *PeriodCollection[int, int] {
 				pc := NewPeriodCollection[int, int]()
-				root := newNode[int, int](NewPeriod(time.Unix(10, 0), time.Unix(25, 0)), 0, 0, black)
-				l := newNode[int, int](NewPeriod(time.Unix(5, 0), time.Unix(30, 0)), 1, 1, red)
-				pc.root = root
+				root := newNode[time.Time, int, int](NewPeriod(time.Unix(10, 0), time.Unix(25, 0)).Interval(), 0, 0, black)
+				l := newNode[time.Time, int, int](NewPeriod(time.Unix(5, 0), time.Unix(30, 0)).Interval(), 1, 1, red)
+				pc.intervals.root = root
 				root.left, l.parent = l, root
-				pc.nodes[0] = root
-				pc.nodes[1] = l
+				pc.intervals.nodes[0] = root
+				pc.intervals.nodes[1] = l
 				return pc
 			},
 			updateKey:   1,
@@ -1856,30 +1835,30 @@ func TestPeriodCollection_Update(t *testing.T) {
 			newPeriod:   NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
 			validate: func(t *testing.T, pc *PeriodCollection[int, int]) {
 				// the node should move from the parent's left to right
-				r, ok := pc.nodes[1]
+				r, ok := pc.intervals.nodes[1]
 				require.True(t, ok)
-				require.Equal(t, r, pc.root.right)
+				require.Equal(t, r, pc.intervals.root.right)
 				assert.Equal(t, r.contents, 2)
-				assert.True(t, pc.root.left.leaf)
-				assert.Len(t, pc.nodes, 2)
+				assert.True(t, pc.intervals.root.left.leaf)
+				assert.Len(t, pc.intervals.nodes, 2)
 			},
 		}, {
 			name: "updating the root's period works",
 			setup: func() *PeriodCollection[int, int] {
 				pc := NewPeriodCollection[int, int]()
-				root := newNode(NewPeriod(time.Unix(10, 0), time.Unix(25, 0)), 0, 0, black)
-				pc.root = root
-				pc.nodes[0] = root
+				root := newNode(NewPeriod(time.Unix(10, 0), time.Unix(25, 0)).Interval(), 0, 0, black)
+				pc.intervals.root = root
+				pc.intervals.nodes[0] = root
 				return pc
 			},
 			newContents: 1,
 			newPeriod:   NewPeriod(time.Unix(10, 0), time.Unix(30, 0)),
 			validate: func(t *testing.T, pc *PeriodCollection[int, int]) {
-				root, ok := pc.nodes[0]
+				root, ok := pc.intervals.nodes[0]
 				require.True(t, ok)
-				assert.Equal(t, root, pc.root)
-				assert.Equal(t, 1, pc.root.contents)
-				assert.Equal(t, time.Unix(30, 0), pc.root.period.End)
+				assert.Equal(t, root, pc.intervals.root)
+				assert.Equal(t, 1, pc.intervals.root.contents)
+				assert.Equal(t, time.Unix(30, 0), pc.intervals.root.interval.End)
 			},
 		},
 	}
@@ -2025,18 +2004,15 @@ func TestPeriodCollection_AnyIntersecting(t *testing.T) {
 			*/
 			name: "searching with in order successor of root as only intersection",
 			createCollection: func(_ *testing.T) *PeriodCollection[int, any] {
-				n := newNode[int, any](Period{time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 2, 0, 0, 0, 0, time.UTC)}, 1, "n", black)
-				l := newNode[int, any](Period{time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 2, 0, 0, 0, 0, time.UTC)}, 2, "l", black)
-				r := newNode[int, any](Period{time.Date(2019, 12, 5, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 6, 0, 0, 0, 0, time.UTC)}, 3, "r", black)
-				rl := newNode[int, any](Period{time.Date(2019, 12, 3, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 4, 0, 0, 0, 0, time.UTC)}, 4, "rl", red)
+				n := newNode[time.Time, int, any](NewPeriod(time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 2, 0, 0, 0, 0, time.UTC)).Interval(), 1, "n", black)
+				l := newNode[time.Time, int, any](NewPeriod(time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 2, 0, 0, 0, 0, time.UTC)).Interval(), 2, "l", black)
+				r := newNode[time.Time, int, any](NewPeriod(time.Date(2019, 12, 5, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 6, 0, 0, 0, 0, time.UTC)).Interval(), 3, "r", black)
+				rl := newNode[time.Time, int, any](NewPeriod(time.Date(2019, 12, 3, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 4, 0, 0, 0, 0, time.UTC)).Interval(), 4, "rl", red)
 				n.left, n.right = l, r
-				r.left, r.parent, r.maxEnd = rl, n, rl.period.End
+				r.left, r.parent, r.maxEnd = rl, n, rl.interval.End
 				l.parent = n
 				rl.parent = r
-				return &PeriodCollection[int, any]{
-					root:  n,
-					nodes: map[int]*node[int, any]{1: n, 2: l, 3: r, 4: rl},
-				}
+				return periodCollectionOf[int, any](n, map[int]*node[time.Time, int, any]{1: n, 2: l, 3: r, 4: rl})
 			},
 			query:           NewPeriod(time.Date(2019, 12, 3, 0, 0, 0, 0, time.UTC), time.Date(2019, 12, 4, 0, 0, 0, 0, time.UTC)),
 			expectedOutcome: true,
@@ -2058,29 +2034,27 @@ func TestPeriodCollection_DepthFirstTraverse(t *testing.T) {
 	  / \
 	 A   B
 	*/
-	tree := PeriodCollection[int, string]{
-		root: &node[int, string]{
-			contents: "D",
-			left: &node[int, string]{
-				contents: "C",
-				left: &node[int, string]{
-					contents: "A",
-					left:     &node[int, string]{leaf: true},
-					right:    &node[int, string]{leaf: true},
-				},
-				right: &node[int, string]{
-					contents: "B",
-					left:     &node[int, string]{leaf: true},
-					right:    &node[int, string]{leaf: true},
-				},
-			},
-			right: &node[int, string]{
-				contents: "E",
-				left:     &node[int, string]{leaf: true},
-				right:    &node[int, string]{leaf: true},
+	tree := periodCollectionOf[int, string](&node[time.Time, int, string]{
+		contents: "D",
+		left: &node[time.Time, int, string]{
+			contents: "C",
+			left: &node[time.Time, int, string]{
+				contents: "A",
+				left:     &node[time.Time, int, string]{leaf: true},
+				right:    &node[time.Time, int, string]{leaf: true},
+			},
+			right: &node[time.Time, int, string]{
+				contents: "B",
+				left:     &node[time.Time, int, string]{leaf: true},
+				right:    &node[time.Time, int, string]{leaf: true},
 			},
 		},
-	}
+		right: &node[time.Time, int, string]{
+			contents: "E",
+			left:     &node[time.Time, int, string]{leaf: true},
+			right:    &node[time.Time, int, string]{leaf: true},
+		},
+	}, nil)
 	tests := []struct {
 		name            string
 		expectedOutcome []string
@@ -2137,7 +2111,7 @@ func TestPeriodCollection_DeleteOnCondition(t *testing.T) {
 				return true
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, 0, len(pc.nodes))
+				assert.Equal(t, 0, len(pc.intervals.nodes))
 			},
 		}, {
 			name: "delete 0 nodes",
@@ -2145,7 +2119,7 @@ func TestPeriodCollection_DeleteOnCondition(t *testing.T) {
 				return false
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, 6, len(pc.nodes))
+				assert.Equal(t, 6, len(pc.intervals.nodes))
 			},
 		}, {
 			name: "delete all even numbers",
@@ -2153,7 +2127,7 @@ func TestPeriodCollection_DeleteOnCondition(t *testing.T) {
 				return i.(int)%2 == 0
 			},
 			validate: func(t *testing.T, pc *PeriodCollection[int, any]) {
-				assert.Equal(t, 3, len(pc.nodes))
+				assert.Equal(t, 3, len(pc.intervals.nodes))
 				assert.True(t, pc.ContainsKey(1))
 				assert.True(t, pc.ContainsKey(3))
 				assert.True(t, pc.ContainsKey(5))
@@ -2199,8 +2173,38 @@ func TestPeriodCollection_Execute(t *testing.T) {
 	}
 	d1 := Delete[int, any]{key: 1, pc: pc}
 	pc.Execute(u1, u2, d1)
-	assert.Contains(t, pc.nodes, 2)
-	assert.NotContains(t, pc.nodes, 1)
+	assert.Contains(t, pc.intervals.nodes, 2)
+	assert.NotContains(t, pc.intervals.nodes, 1)
+}
+
+func TestNewValidatingPeriodCollection(t *testing.T) {
+	valid := NewPeriod(time.Unix(1, 0), time.Unix(2, 0))
+	invalid := NewPeriod(time.Unix(2, 0), time.Unix(1, 0))
+	pc := NewValidatingPeriodCollection[int, any]()
+	require.NoError(t, pc.Insert(1, valid, 1))
+	assert.IsType(t, PeriodConstructionError(""), pc.Insert(2, invalid, 2))
+	assert.NotContains(t, pc.intervals.nodes, 2)
+	assert.IsType(t, PeriodConstructionError(""), pc.TryUpdate(1, invalid, 1))
+	pc.Update(1, invalid, 1)
+	assert.True(t, NewPeriodFromInterval(pc.intervals.nodes[1].interval).Equals(valid))
+	assert.Error(t, pc.TryExecute(pc.PrepareDelete(1), pc.PrepareUpdate(3, invalid, 3)))
+	pc.Execute(pc.PrepareDelete(1), pc.PrepareUpdate(3, invalid, 3))
+	assert.Contains(t, pc.intervals.nodes, 1)
+	assert.NotContains(t, pc.intervals.nodes, 3)
+	assert.NoError(t, pc.TryExecute(pc.PrepareDelete(1), pc.PrepareUpdate(3, valid, 3)))
+	assert.NotContains(t, pc.intervals.nodes, 1)
+	assert.Contains(t, pc.intervals.nodes, 3)
+}
+
+func TestPeriodCollection_InvalidPeriodsAllowedByDefault(t *testing.T) {
+	invalid := NewPeriod(time.Unix(2, 0), time.Unix(1, 0))
+	pc := NewPeriodCollection[int, any]()
+	assert.NoError(t, pc.Insert(1, invalid, 1))
+	assert.NoError(t, pc.TryUpdate(2, invalid, 2))
+	assert.NoError(t, pc.TryExecute(pc.PrepareUpdate(3, invalid, 3)))
+	pc.Update(4, invalid, 4)
+	pc.Execute(pc.PrepareUpdate(5, invalid, 5))
+	assert.Len(t, pc.intervals.nodes, 5)
 }
 
 func TestPeriodCollection_PrepareDelete(t *testing.T) {
@@ -2217,20 +2221,20 @@ func TestUpdate_execute(t *testing.T) {
 		pc:          pc,
 	}
 	u.execute()
-	assert.Contains(t, pc.nodes, 1)
+	assert.Contains(t, pc.intervals.nodes, 1)
 }
 
 func TestDelete_execute(t *testing.T) {
 	pc := NewPeriodCollection[int, any]()
 	pc.update(1, Period{}, 1)
-	require.Contains(t, pc.nodes, 1)
+	require.Contains(t, pc.intervals.nodes, 1)
 	d := Delete[int, any]{key: 1, pc: pc}
 	d.execute()
-	assert.NotContains(t, pc.nodes, 1)
+	assert.NotContains(t, pc.intervals.nodes, 1)
 }
 
 func TestPeriodCollection_ContentsOfKey(t *testing.T) {
-	pc := PeriodCollection[int, any]{nodes: map[int]*node[int, any]{1: {contents: "contents"}}}
+	pc := periodCollectionOf[int, any](nil, map[int]*node[time.Time, int, any]{1: {contents: "contents"}})
 	tests := []struct {
 		expectedContents any
 		name             string
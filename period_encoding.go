@@ -0,0 +1,274 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// unboundedText is the ISO 8601 notation for an unbounded start or end of an interval
+const unboundedText = ".."
+
+// ParsePeriod parses an ISO 8601 time interval into a Period. The start and end of the interval are separated by a
+// slash and may each be an RFC 3339 timestamp, or one of them may be an ISO 8601 duration such as "PT2H" or "P1D"
+// that is measured from the other. An unbounded start or end is written as ".." or left empty. For example, all of
+// the following are valid:
+//
+//	2019-01-01T09:00:00Z/2019-01-01T11:00:00Z
+//	2019-01-01T09:00:00Z/PT2H
+//	P1D/2019-01-02T00:00:00Z
+//	2019-01-01T09:00:00Z/..
+//
+// The interval may also be enclosed in the interval notation of its Bounds, eg "(2019-01-01T09:00:00Z/PT2H]";
+// otherwise the Period has the default ClosedOpen bounds. Years, months, weeks and days in a duration are calendar
+// units, so "P1D" is one calendar day in the location of the timestamp it is measured from. As with UnmarshalJSON, an
+// error is returned if the start is after the end.
+func ParsePeriod(s string) (Period, error) {
+	var p Period
+	text := s
+	if len(text) >= 2 && strings.ContainsAny(text[:1], "[(") {
+		if err := p.Bounds.UnmarshalText([]byte(text[:1] + text[len(text)-1:])); err != nil {
+			return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+		}
+		text = text[1 : len(text)-1]
+	}
+	startText, endText, found := strings.Cut(text, "/")
+	if !found {
+		return Period{}, fmt.Errorf("invalid period %q: missing '/' between start and end", s)
+	}
+	startDuration, endDuration := strings.HasPrefix(startText, "P"), strings.HasPrefix(endText, "P")
+	var err error
+	switch {
+	case startDuration && endDuration:
+		return Period{}, fmt.Errorf("invalid period %q: start and end cannot both be durations", s)
+	case startDuration:
+		if p.End, err = parseBoundary(endText); err != nil {
+			return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+		}
+		if p.End.IsZero() {
+			return Period{}, fmt.Errorf("invalid period %q: duration cannot be measured from an unbounded end", s)
+		}
+		var d isoDuration
+		if d, err = parseISODuration(startText); err != nil {
+			return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+		}
+		p.Start = d.subtractFrom(p.End)
+	case endDuration:
+		if p.Start, err = parseBoundary(startText); err != nil {
+			return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+		}
+		if p.Start.IsZero() {
+			return Period{}, fmt.Errorf("invalid period %q: duration cannot be measured from an unbounded start", s)
+		}
+		var d isoDuration
+		if d, err = parseISODuration(endText); err != nil {
+			return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+		}
+		p.End = d.addTo(p.Start)
+	default:
+		if p.Start, err = parseBoundary(startText); err != nil {
+			return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+		}
+		if p.End, err = parseBoundary(endText); err != nil {
+			return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+		}
+	}
+	if err = p.Validate(); err != nil {
+		return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+	}
+	return p, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, formatting the Period as an ISO 8601 time interval of two RFC 3339
+// timestamps, with ".." for an unbounded start or end. Periods that do not have the default ClosedOpen bounds are
+// enclosed in the interval notation of their Bounds. The result can be parsed by ParsePeriod.
+func (p Period) MarshalText() ([]byte, error) {
+	bounds, err := p.Bounds.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	if p.Bounds != ClosedOpen {
+		b.WriteByte(bounds[0])
+	}
+	b.WriteString(formatBoundary(p.Start))
+	b.WriteByte('/')
+	b.WriteString(formatBoundary(p.End))
+	if p.Bounds != ClosedOpen {
+		b.WriteByte(bounds[1])
+	}
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting any interval that can be parsed by ParsePeriod.
+func (p *Period) UnmarshalText(text []byte) error {
+	parsed, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// periodJSON is the JSON representation of a Period, in which an unbounded start or end is null.
+type periodJSON struct {
+	Start  *time.Time `json:"start"`
+	End    *time.Time `json:"end"`
+	Bounds Bounds     `json:"bounds,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, encoding the Period as an object with start, end and bounds fields. An
+// unbounded start or end is encoded as null rather than as the zero time.
+func (p Period) MarshalJSON() ([]byte, error) {
+	encoded := periodJSON{Bounds: p.Bounds}
+	if !p.Start.IsZero() {
+		encoded.Start = &p.Start
+	}
+	if !p.End.IsZero() {
+		encoded.End = &p.End
+	}
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding an object with start, end and bounds fields. A start or end
+// that is null, missing or the zero time is unbounded. An error is returned if the start is after the end.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	var decoded periodJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	var result Period
+	if decoded.Start != nil {
+		result.Start = *decoded.Start
+	}
+	if decoded.End != nil {
+		result.End = *decoded.End
+	}
+	result.Bounds = decoded.Bounds
+	if err := result.Validate(); err != nil {
+		return err
+	}
+	*p = result
+	return nil
+}
+
+// formatBoundary formats the start or end time of a period, returning ".." if it is unbounded.
+func formatBoundary(t time.Time) string {
+	if t.IsZero() {
+		return unboundedText
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// parseBoundary parses the start or end time of a period, returning the zero time if it is unbounded.
+func parseBoundary(s string) (time.Time, error) {
+	if s == "" || s == unboundedText {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, s)
+}
+
+// isoDuration is an ISO 8601 duration, split into calendar units and a fixed length of time.
+type isoDuration struct {
+	years, months, days int
+	d                   time.Duration
+}
+
+// addTo returns the time the duration after t.
+func (d isoDuration) addTo(t time.Time) time.Time {
+	return t.AddDate(d.years, d.months, d.days).Add(d.d)
+}
+
+// subtractFrom returns the time the duration before t.
+func (d isoDuration) subtractFrom(t time.Time) time.Time {
+	return t.Add(-d.d).AddDate(-d.years, -d.months, -d.days)
+}
+
+// parseISODuration parses an ISO 8601 duration of the form PnYnMnDTnHnMnS or PnW. Only the hours, minutes and seconds
+// may have a fractional part.
+func parseISODuration(s string) (isoDuration, error) {
+	var d isoDuration
+	rest, ok := strings.CutPrefix(s, "P")
+	datePart, timePart, hasTime := strings.Cut(rest, "T")
+	if !ok || rest == "" || (hasTime && timePart == "") {
+		return isoDuration{}, fmt.Errorf("invalid duration %q", s)
+	}
+	err := parseDurationUnits(datePart, "YMWD", func(unit byte, number string) error {
+		value, parseErr := strconv.Atoi(number)
+		if parseErr != nil {
+			return parseErr
+		}
+		switch unit {
+		case 'Y':
+			d.years = value
+		case 'M':
+			d.months = value
+		case 'W':
+			d.days += value * DaysInWeek
+		case 'D':
+			d.days += value
+		}
+		return nil
+	})
+	if err != nil {
+		return isoDuration{}, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	err = parseDurationUnits(timePart, "HMS", func(unit byte, number string) error {
+		value, parseErr := strconv.ParseFloat(strings.Replace(number, ",", ".", 1), 64)
+		if parseErr != nil {
+			return parseErr
+		}
+		switch unit {
+		case 'H':
+			d.d += time.Duration(value * float64(time.Hour))
+		case 'M':
+			d.d += time.Duration(value * float64(time.Minute))
+		case 'S':
+			d.d += time.Duration(value * float64(time.Second))
+		}
+		return nil
+	})
+	if err != nil {
+		return isoDuration{}, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	return d, nil
+}
+
+// parseDurationUnits splits part of an ISO 8601 duration into numbers and their units, calling set with each. Units
+// must be one of the given units and appear in the same order.
+func parseDurationUnits(s, units string, set func(unit byte, number string) error) error {
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if i < 0 {
+			return fmt.Errorf("missing unit after %q", s)
+		}
+		if i == 0 {
+			return fmt.Errorf("missing number before %q", s)
+		}
+		pos := strings.IndexByte(units, s[i])
+		if pos < 0 {
+			return fmt.Errorf("unexpected unit %q", s[i])
+		}
+		if err := set(s[i], s[:i]); err != nil {
+			return err
+		}
+		s, units = s[i+1:], units[pos+1:]
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		name        string
+		s           string
+		expected    Period
+		expectError bool
+	}{
+		{
+			name:     "start and end",
+			s:        "2019-01-01T09:00:00Z/2019-01-01T11:00:00Z",
+			expected: NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "start and duration",
+			s:        "2019-01-01T09:00:00Z/PT2H",
+			expected: NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "duration and end",
+			s:        "P1D/2019-01-02T00:00:00Z",
+			expected: NewPeriod(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "duration with calendar and time units",
+			s:        "2019-01-31T00:00:00Z/P1M1W1DT1H30M1.5S",
+			expected: NewPeriod(time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2019, 3, 11, 1, 30, 1, 500000000, time.UTC)),
+		}, {
+			name:     "unbounded end",
+			s:        "2019-01-01T09:00:00Z/..",
+			expected: NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}),
+		}, {
+			name:     "empty start is unbounded",
+			s:        "/2019-01-01T09:00:00Z",
+			expected: NewPeriod(time.Time{}, time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "unbounded start and end",
+			s:        "../..",
+			expected: Period{},
+		}, {
+			name: "bounds",
+			s:    "(2019-01-01T09:00:00Z/PT2H]",
+			expected: NewBoundedPeriod(
+				time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC), OpenClosed),
+		}, {
+			name:        "missing separator",
+			s:           "2019-01-01T09:00:00Z",
+			expectError: true,
+		}, {
+			name:        "two durations",
+			s:           "PT1H/PT2H",
+			expectError: true,
+		}, {
+			name:        "duration from an unbounded end",
+			s:           "P1D/..",
+			expectError: true,
+		}, {
+			name:        "duration from an unbounded start",
+			s:           "../PT2H",
+			expectError: true,
+		}, {
+			name:        "invalid timestamp",
+			s:           "2019-01-01/2019-01-02",
+			expectError: true,
+		}, {
+			name:        "units out of order",
+			s:           "2019-01-01T09:00:00Z/PT1M1H",
+			expectError: true,
+		}, {
+			name:        "fractional calendar unit",
+			s:           "2019-01-01T09:00:00Z/P1.5D",
+			expectError: true,
+		}, {
+			name:        "missing unit",
+			s:           "2019-01-01T09:00:00Z/PT1",
+			expectError: true,
+		}, {
+			name:        "empty time part",
+			s:           "2019-01-01T09:00:00Z/P1DT",
+			expectError: true,
+		}, {
+			name:        "invalid bounds",
+			s:           "[2019-01-01T09:00:00Z/PT2H",
+			expectError: true,
+		}, {
+			name:        "start after end",
+			s:           "2019-01-01T09:00:00Z/2018-01-01T00:00:00Z",
+			expectError: true,
+		}, {
+			name:        "negative duration",
+			s:           "2019-01-01T09:00:00Z/PT-2H",
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ParsePeriod(test.s)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
+
+func TestPeriod_MarshalText(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		p        Period
+	}{
+		{
+			name:     "bounded period",
+			p:        NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+			expected: "2019-01-01T09:00:00Z/2019-01-01T11:00:00Z",
+		}, {
+			name:     "unbounded ends",
+			p:        NewPeriod(time.Time{}, time.Date(2019, 1, 1, 11, 0, 0, 500, time.FixedZone("", -5*60*60))),
+			expected: "../2019-01-01T11:00:00.0000005-05:00",
+		}, {
+			name: "bounds",
+			p: NewBoundedPeriod(
+				time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC), ClosedClosed),
+			expected: "[2019-01-01T09:00:00Z/2019-01-01T11:00:00Z]",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			text, err := test.p.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, string(text))
+			var roundTrip Period
+			require.NoError(t, roundTrip.UnmarshalText(text))
+			assert.True(t, test.p.Equals(roundTrip))
+		})
+	}
+}
+
+func TestPeriod_MarshalText_InvalidBounds(t *testing.T) {
+	_, err := NewBoundedPeriod(time.Time{}, time.Time{}, Bounds(9)).MarshalText()
+	assert.Error(t, err)
+	var p Period
+	assert.Error(t, p.UnmarshalText([]byte("2019-01-01T09:00:00Z")))
+	assert.ErrorAs(t, p.UnmarshalText([]byte("2019-01-01T09:00:00Z/2018-01-01T00:00:00Z")), new(PeriodConstructionError))
+}
+
+func TestPeriod_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		p        Period
+	}{
+		{
+			name: "bounded period",
+			p: NewBoundedPeriod(
+				time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC), ClosedClosed),
+			expected: `{"start": "2019-01-01T09:00:00Z", "end": "2019-01-01T11:00:00Z", "bounds": "[]"}`,
+		}, {
+			name:     "unbounded end is null",
+			p:        NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}),
+			expected: `{"start": "2019-01-01T09:00:00Z", "end": null}`,
+		}, {
+			name:     "unbounded start and end are null",
+			p:        Period{},
+			expected: `{"start": null, "end": null}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(test.p)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(data))
+			var roundTrip Period
+			require.NoError(t, json.Unmarshal(data, &roundTrip))
+			assert.True(t, test.p.Equals(roundTrip))
+		})
+	}
+}
+
+func TestPeriod_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		expected    Period
+		expectError bool
+	}{
+		{
+			name:     "bounded period",
+			data:     `{"start": "2019-01-01T09:00:00Z", "end": "2019-01-01T11:00:00Z"}`,
+			expected: NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "null start is unbounded",
+			data:     `{"start": null, "end": "2019-01-01T11:00:00Z"}`,
+			expected: NewPeriod(time.Time{}, time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "missing end is unbounded",
+			data:     `{"start": "2019-01-01T09:00:00Z"}`,
+			expected: NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}),
+		}, {
+			name:     "zero timestamp is unbounded",
+			data:     `{"start": "0001-01-01T00:00:00Z", "end": "2019-01-01T11:00:00Z"}`,
+			expected: NewPeriod(time.Time{}, time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "start after end",
+			data:        `{"start": "2019-01-01T11:00:00Z", "end": "2019-01-01T09:00:00Z"}`,
+			expectError: true,
+		}, {
+			name:        "invalid timestamp",
+			data:        `{"start": "yesterday"}`,
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var result Period
+			err := json.Unmarshal([]byte(test.data), &result)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
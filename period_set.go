@@ -0,0 +1,136 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"time"
+)
+
+// PeriodSet is an immutable set of periods that is always kept normalized; that is, the periods in the set are
+// sorted by start time and no two periods in the set intersect or could be merged into one. Like Period, a
+// zero-valued start or end time is treated as unbounded on that side. The zero value of PeriodSet is an empty set.
+type PeriodSet struct {
+	periods []Period
+}
+
+// NewPeriodSet constructs a new PeriodSet containing the union of the given periods. Periods that encompass no time
+// are discarded. The given slice is not modified.
+func NewPeriodSet(periods ...Period) PeriodSet {
+	normalized := make([]Period, 0, len(periods))
+	for _, period := range periods {
+		if !period.empty() {
+			normalized = append(normalized, period)
+		}
+	}
+	return PeriodSet{periods: MergePeriods(normalized)}
+}
+
+// Periods returns the normalized periods contained in the set, sorted by start time.
+func (ps PeriodSet) Periods() []Period {
+	periods := make([]Period, len(ps.periods))
+	copy(periods, ps.periods)
+	return periods
+}
+
+// IsEmpty returns whether the set contains no time at all.
+func (ps PeriodSet) IsEmpty() bool {
+	return len(ps.periods) == 0
+}
+
+// ContainsTime returns whether any period in the set contains the given time.
+func (ps PeriodSet) ContainsTime(t time.Time) bool {
+	for _, period := range ps.periods {
+		if period.ContainsTime(t, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// Union returns a set containing all time that is in either set.
+func (ps PeriodSet) Union(other PeriodSet) PeriodSet {
+	periods := make([]Period, 0, len(ps.periods)+len(other.periods))
+	periods = append(periods, ps.periods...)
+	periods = append(periods, other.periods...)
+	return NewPeriodSet(periods...)
+}
+
+// Intersect returns a set containing only the time that is in both sets.
+func (ps PeriodSet) Intersect(other PeriodSet) PeriodSet {
+	return NewPeriodSet(intersections(ps.periods, other.periods)...)
+}
+
+// intersections returns the intersections of the periods in a with the periods in b, where both lists are sorted by
+// start time and no two periods in the same list intersect, in a single sweep over both lists.
+func intersections(a, b []Period) []Period {
+	periods := make([]Period, 0)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if intersection, ok := a[i].Intersection(b[j]); ok {
+			periods = append(periods, intersection)
+		}
+		// Since both lists are sorted and disjoint, whichever period ends first cannot intersect any
+		// later period in the other list.
+		if endsBefore(a[i], b[j]) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return periods
+}
+
+// Subtract returns a set containing the time that is in the set upon which the method was called but not in the
+// other set.
+func (ps PeriodSet) Subtract(other PeriodSet) PeriodSet {
+	periods := make([]Period, 0, len(ps.periods))
+	for _, period := range ps.periods {
+		remaining := []Period{period}
+		for _, subtrahend := range other.periods {
+			next := make([]Period, 0, len(remaining))
+			for _, r := range remaining {
+				if r.Intersects(subtrahend) {
+					next = append(next, r.Difference(subtrahend)...)
+				} else {
+					next = append(next, r)
+				}
+			}
+			remaining = next
+		}
+		periods = append(periods, remaining...)
+	}
+	return NewPeriodSet(periods...)
+}
+
+// SymmetricDifference returns a set containing the time that is in exactly one of the two sets.
+func (ps PeriodSet) SymmetricDifference(other PeriodSet) PeriodSet {
+	return ps.Subtract(other).Union(other.Subtract(ps))
+}
+
+// Complement returns a set containing the time within the given period that is not in the set.
+func (ps PeriodSet) Complement(within Period) PeriodSet {
+	return NewPeriodSet(within).Subtract(ps)
+}
+
+// empty returns whether the period encompasses no time. Unlike IsZero, a period that is unbounded on either end is
+// never empty, and a period that starts and ends at the same time is not empty if its bounds include both ends.
+func (p Period) empty() bool {
+	return !startsBeforeEnd(p, p)
+}
+
+// endsBefore returns whether period a ends before period b, treating a zero end time as unbounded.
+func endsBefore(a, b Period) bool {
+	return compareEnds(a, b) < 0
+}
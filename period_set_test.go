@@ -0,0 +1,271 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeriodSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		periods  []Period
+		expected []Period
+	}{
+		{
+			name:     "no periods returns an empty set",
+			expected: []Period{},
+		}, {
+			name: "periods are sorted and merged",
+			periods: []Period{
+				NewPeriod(time.Unix(40, 0), time.Unix(50, 0)),
+				NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+				NewPeriod(time.Unix(15, 0), time.Unix(30, 0)),
+			},
+			expected: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(30, 0)),
+				NewPeriod(time.Unix(40, 0), time.Unix(50, 0)),
+			},
+		}, {
+			name: "empty periods are discarded",
+			periods: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(10, 0)),
+				NewPeriod(time.Unix(30, 0), time.Unix(20, 0)),
+			},
+			expected: []Period{},
+		}, {
+			name: "unbounded periods are kept",
+			periods: []Period{
+				NewPeriod(time.Unix(40, 0), time.Time{}),
+				NewPeriod(time.Time{}, time.Unix(20, 0)),
+			},
+			expected: []Period{
+				NewPeriod(time.Time{}, time.Unix(20, 0)),
+				NewPeriod(time.Unix(40, 0), time.Time{}),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, NewPeriodSet(test.periods...).Periods())
+		})
+	}
+}
+
+func TestNewPeriodSet_doesNotModifyInput(t *testing.T) {
+	periods := []Period{
+		NewPeriod(time.Unix(40, 0), time.Unix(50, 0)),
+		NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+	}
+	NewPeriodSet(periods...)
+	assert.Equal(t, time.Unix(40, 0), periods[0].Start)
+}
+
+func TestPeriodSet_IsEmpty(t *testing.T) {
+	assert.True(t, PeriodSet{}.IsEmpty())
+	assert.True(t, NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Unix(10, 0))).IsEmpty())
+	assert.False(t, NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Unix(20, 0))).IsEmpty())
+}
+
+func TestPeriodSet_ContainsTime(t *testing.T) {
+	ps := NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Unix(20, 0)), NewPeriod(time.Unix(30, 0), time.Time{}))
+	tests := []struct {
+		t        time.Time
+		name     string
+		expected bool
+	}{
+		{name: "time before all periods is not contained", t: time.Unix(5, 0)},
+		{name: "time within a period is contained", t: time.Unix(15, 0), expected: true},
+		{name: "time between periods is not contained", t: time.Unix(25, 0)},
+		{name: "time within an unbounded period is contained", t: time.Unix(1000, 0), expected: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ps.ContainsTime(test.t))
+		})
+	}
+}
+
+func TestPeriodSet_Union(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     PeriodSet
+		expected []Period
+	}{
+		{
+			name:     "union of empty sets is empty",
+			expected: []Period{},
+		}, {
+			name: "overlapping periods are merged",
+			a:    NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Unix(30, 0))),
+			b:    NewPeriodSet(NewPeriod(time.Unix(20, 0), time.Unix(40, 0)), NewPeriod(time.Unix(50, 0), time.Unix(60, 0))),
+			expected: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(40, 0)),
+				NewPeriod(time.Unix(50, 0), time.Unix(60, 0)),
+			},
+		}, {
+			name: "unbounded period absorbs later periods",
+			a:    NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Time{})),
+			b:    NewPeriodSet(NewPeriod(time.Unix(20, 0), time.Unix(40, 0))),
+			expected: []Period{
+				NewPeriod(time.Unix(10, 0), time.Time{}),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.a.Union(test.b).Periods())
+		})
+	}
+}
+
+func TestPeriodSet_Intersect(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     PeriodSet
+		expected []Period
+	}{
+		{
+			name:     "intersection with an empty set is empty",
+			a:        NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Unix(30, 0))),
+			expected: []Period{},
+		}, {
+			name: "overlaps of every pair of periods are returned",
+			a: NewPeriodSet(
+				NewPeriod(time.Unix(10, 0), time.Unix(30, 0)),
+				NewPeriod(time.Unix(40, 0), time.Unix(60, 0)),
+			),
+			b: NewPeriodSet(
+				NewPeriod(time.Unix(20, 0), time.Unix(50, 0)),
+				NewPeriod(time.Unix(55, 0), time.Unix(70, 0)),
+			),
+			expected: []Period{
+				NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+				NewPeriod(time.Unix(40, 0), time.Unix(50, 0)),
+				NewPeriod(time.Unix(55, 0), time.Unix(60, 0)),
+			},
+		}, {
+			name: "unbounded periods intersect with an unbounded result",
+			a:    NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Time{})),
+			b:    NewPeriodSet(NewPeriod(time.Time{}, time.Unix(20, 0)), NewPeriod(time.Unix(30, 0), time.Time{})),
+			expected: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+				NewPeriod(time.Unix(30, 0), time.Time{}),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.a.Intersect(test.b).Periods())
+		})
+	}
+}
+
+func TestPeriodSet_Subtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     PeriodSet
+		expected []Period
+	}{
+		{
+			name:     "subtracting an empty set returns the set",
+			a:        NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Unix(30, 0))),
+			expected: []Period{NewPeriod(time.Unix(10, 0), time.Unix(30, 0))},
+		}, {
+			name: "multiple periods are subtracted from a single period",
+			a:    NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Unix(100, 0))),
+			b: NewPeriodSet(
+				NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+				NewPeriod(time.Unix(50, 0), time.Unix(60, 0)),
+				NewPeriod(time.Unix(90, 0), time.Unix(110, 0)),
+			),
+			expected: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+				NewPeriod(time.Unix(30, 0), time.Unix(50, 0)),
+				NewPeriod(time.Unix(60, 0), time.Unix(90, 0)),
+			},
+		}, {
+			name: "subtracting from an unbounded period keeps the unbounded end",
+			a:    NewPeriodSet(NewPeriod(time.Time{}, time.Time{})),
+			b:    NewPeriodSet(NewPeriod(time.Unix(20, 0), time.Unix(30, 0))),
+			expected: []Period{
+				NewPeriod(time.Time{}, time.Unix(20, 0)),
+				NewPeriod(time.Unix(30, 0), time.Time{}),
+			},
+		}, {
+			name:     "subtracting a covering set returns an empty set",
+			a:        NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Unix(30, 0))),
+			b:        NewPeriodSet(NewPeriod(time.Unix(5, 0), time.Time{})),
+			expected: []Period{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.a.Subtract(test.b).Periods())
+		})
+	}
+}
+
+func TestPeriodSet_SymmetricDifference(t *testing.T) {
+	a := NewPeriodSet(NewPeriod(time.Unix(10, 0), time.Unix(30, 0)))
+	b := NewPeriodSet(NewPeriod(time.Unix(20, 0), time.Unix(40, 0)))
+	assert.Equal(
+		t,
+		[]Period{
+			NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+			NewPeriod(time.Unix(30, 0), time.Unix(40, 0)),
+		},
+		a.SymmetricDifference(b).Periods(),
+	)
+}
+
+func TestPeriodSet_Complement(t *testing.T) {
+	tests := []struct {
+		name     string
+		ps       PeriodSet
+		within   Period
+		expected []Period
+	}{
+		{
+			name:     "complement of an empty set is the bounding period",
+			within:   NewPeriod(time.Unix(10, 0), time.Unix(30, 0)),
+			expected: []Period{NewPeriod(time.Unix(10, 0), time.Unix(30, 0))},
+		}, {
+			name:   "gaps between periods are returned",
+			ps:     NewPeriodSet(NewPeriod(time.Unix(5, 0), time.Unix(20, 0)), NewPeriod(time.Unix(25, 0), time.Unix(28, 0))),
+			within: NewPeriod(time.Unix(10, 0), time.Unix(30, 0)),
+			expected: []Period{
+				NewPeriod(time.Unix(20, 0), time.Unix(25, 0)),
+				NewPeriod(time.Unix(28, 0), time.Unix(30, 0)),
+			},
+		}, {
+			name:   "complement within an unbounded period is unbounded",
+			ps:     NewPeriodSet(NewPeriod(time.Unix(5, 0), time.Unix(20, 0))),
+			within: NewPeriod(time.Time{}, time.Time{}),
+			expected: []Period{
+				NewPeriod(time.Time{}, time.Unix(5, 0)),
+				NewPeriod(time.Unix(20, 0), time.Time{}),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.ps.Complement(test.within).Periods())
+		})
+	}
+}
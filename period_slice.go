@@ -0,0 +1,125 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"sort"
+	"time"
+)
+
+// PeriodSlice is a list of periods that can be sorted and searched, for read-only lists of periods where a
+// PeriodCollection is not needed. Like Period, a zero-valued start or end time is treated as unbounded on that side,
+// so periods that are unbounded on the start sort first and periods that are unbounded on the end sort last.
+//
+// PeriodSlice implements sort.Interface, ordering periods by start and then by end. The search methods require the
+// slice to be sorted in this order, eg by SortByStart.
+type PeriodSlice []Period
+
+// Len implements sort.Interface for PeriodSlice
+func (ps PeriodSlice) Len() int {
+	return len(ps)
+}
+
+// Less implements sort.Interface for PeriodSlice, ordering periods by start and then by end
+func (ps PeriodSlice) Less(i, j int) bool {
+	if c := compareStarts(ps[i], ps[j]); c != 0 {
+		return c < 0
+	}
+	return compareEnds(ps[i], ps[j]) < 0
+}
+
+// Swap implements sort.Interface for PeriodSlice
+func (ps PeriodSlice) Swap(i, j int) {
+	ps[i], ps[j] = ps[j], ps[i]
+}
+
+// SortByStart sorts the slice in place by start and then by end.
+func (ps PeriodSlice) SortByStart() {
+	sort.Stable(ps)
+}
+
+// SortByEnd sorts the slice in place by end and then by start.
+func (ps PeriodSlice) SortByEnd() {
+	sort.SliceStable(ps, func(i, j int) bool {
+		if c := compareEnds(ps[i], ps[j]); c != 0 {
+			return c < 0
+		}
+		return compareStarts(ps[i], ps[j]) < 0
+	})
+}
+
+// IsSortedByStart returns whether the slice is sorted by start and then by end.
+func (ps PeriodSlice) IsSortedByStart() bool {
+	return sort.IsSorted(ps)
+}
+
+// SearchStart returns the index of the first period that starts at or after t, or the length of the slice if there
+// is no such period. Periods that are unbounded on the start never start at or after t. The slice must be sorted by
+// start.
+func (ps PeriodSlice) SearchStart(t time.Time) int {
+	return sort.Search(len(ps), func(i int) bool {
+		return !ps[i].Start.IsZero() && !ps[i].Start.Before(t)
+	})
+}
+
+// Containing returns the periods that contain t, in the order they appear in the slice. A binary search skips the
+// periods that start after t, so the lookup takes time proportional to the number of periods that start at or before
+// t. The slice must be sorted by start.
+func (ps PeriodSlice) Containing(t time.Time) PeriodSlice {
+	// no period from the first period that starts after t onwards can contain t
+	end := sort.Search(len(ps), func(i int) bool {
+		return !ps[i].Start.IsZero() && ps[i].Start.After(t)
+	})
+	containing := make(PeriodSlice, 0)
+	for _, p := range ps[:end] {
+		if p.ContainsTime(t, false) {
+			containing = append(containing, p)
+		}
+	}
+	return containing
+}
+
+// Merge returns a new PeriodSlice, sorted by start, in which runs of intersecting periods are merged as by
+// MergePeriods. The slice is not modified.
+func (ps PeriodSlice) Merge() PeriodSlice {
+	return MergePeriods(ps)
+}
+
+// Dedupe returns a new PeriodSlice, sorted by start, with only the first of each set of periods that start and end at
+// the same times with the same inclusivity. As when sorting, the inclusivity of an unbounded start or end is ignored,
+// so unlike Period.Equals, periods that differ only in the bounds of an unbounded side are duplicates. The slice is not
+// modified.
+func (ps PeriodSlice) Dedupe() PeriodSlice {
+	sorted := make(PeriodSlice, len(ps))
+	copy(sorted, ps)
+	sorted.SortByStart()
+	deduped := sorted[:0]
+	for i, p := range sorted {
+		if i == 0 || compareStarts(p, deduped[len(deduped)-1]) != 0 || compareEnds(p, deduped[len(deduped)-1]) != 0 {
+			deduped = append(deduped, p)
+		}
+	}
+	return deduped
+}
+
+// Collection returns a new PeriodCollection containing the periods in the slice, where the key of each period is its
+// index in the slice and its contents are the period itself.
+func (ps PeriodSlice) Collection() *PeriodCollection[int, Period] {
+	pc := NewPeriodCollection[int, Period]()
+	for i, p := range ps {
+		pc.intervals.insert(i, p.Interval(), p)
+	}
+	return pc
+}
@@ -0,0 +1,152 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodSlice_Sort(t *testing.T) {
+	ps := PeriodSlice{
+		NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+		NewPeriod(time.Unix(10, 0), time.Time{}),
+		NewPeriod(time.Unix(10, 0), time.Unix(40, 0)),
+		NewPeriod(time.Time{}, time.Unix(50, 0)),
+	}
+	ps.SortByStart()
+	assert.Equal(t, PeriodSlice{
+		NewPeriod(time.Time{}, time.Unix(50, 0)),
+		NewPeriod(time.Unix(10, 0), time.Unix(40, 0)),
+		NewPeriod(time.Unix(10, 0), time.Time{}),
+		NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+	}, ps)
+	assert.True(t, ps.IsSortedByStart())
+	ps.SortByEnd()
+	assert.Equal(t, PeriodSlice{
+		NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+		NewPeriod(time.Unix(10, 0), time.Unix(40, 0)),
+		NewPeriod(time.Time{}, time.Unix(50, 0)),
+		NewPeriod(time.Unix(10, 0), time.Time{}),
+	}, ps)
+	assert.False(t, ps.IsSortedByStart())
+	sort.Sort(ps)
+	assert.True(t, ps.IsSortedByStart())
+}
+
+func TestPeriodSlice_SearchStart(t *testing.T) {
+	ps := PeriodSlice{
+		NewPeriod(time.Time{}, time.Unix(50, 0)),
+		NewPeriod(time.Unix(10, 0), time.Unix(40, 0)),
+		NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+		NewPeriod(time.Unix(20, 0), time.Time{}),
+	}
+	tests := []struct {
+		t        time.Time
+		name     string
+		expected int
+	}{
+		{name: "before every bounded start", t: time.Unix(0, 0), expected: 1},
+		{name: "at a start", t: time.Unix(10, 0), expected: 1},
+		{name: "first of equal starts", t: time.Unix(15, 0), expected: 2},
+		{name: "after every start", t: time.Unix(25, 0), expected: 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ps.SearchStart(test.t))
+		})
+	}
+}
+
+func TestPeriodSlice_Containing(t *testing.T) {
+	ps := PeriodSlice{
+		NewPeriod(time.Time{}, time.Unix(15, 0)),
+		NewPeriod(time.Unix(10, 0), time.Unix(40, 0)),
+		NewBoundedPeriod(time.Unix(20, 0), time.Unix(30, 0), OpenOpen),
+		NewPeriod(time.Unix(30, 0), time.Time{}),
+	}
+	tests := []struct {
+		name     string
+		t        time.Time
+		expected PeriodSlice
+	}{
+		{
+			name:     "unbounded start",
+			t:        time.Unix(0, 0),
+			expected: PeriodSlice{NewPeriod(time.Time{}, time.Unix(15, 0))},
+		}, {
+			name:     "overlapping periods",
+			t:        time.Unix(12, 0),
+			expected: PeriodSlice{NewPeriod(time.Time{}, time.Unix(15, 0)), NewPeriod(time.Unix(10, 0), time.Unix(40, 0))},
+		}, {
+			name:     "exclusive start",
+			t:        time.Unix(20, 0),
+			expected: PeriodSlice{NewPeriod(time.Unix(10, 0), time.Unix(40, 0))},
+		}, {
+			name:     "unbounded end",
+			t:        time.Unix(100, 0),
+			expected: PeriodSlice{NewPeriod(time.Unix(30, 0), time.Time{})},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ps.Containing(test.t))
+		})
+	}
+	assert.Equal(t, PeriodSlice{}, PeriodSlice{}.Containing(time.Unix(0, 0)))
+}
+
+func TestPeriodSlice_MergeDedupe(t *testing.T) {
+	ps := PeriodSlice{
+		NewPeriod(time.Unix(30, 0), time.Unix(40, 0)),
+		NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+		NewPeriod(time.Unix(30, 0), time.Unix(40, 0)),
+		NewBoundedPeriod(time.Unix(10, 0), time.Unix(20, 0), ClosedClosed),
+		NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+	}
+	original := append(PeriodSlice{}, ps...)
+	assert.Equal(t, PeriodSlice{
+		NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+		NewBoundedPeriod(time.Unix(10, 0), time.Unix(20, 0), ClosedClosed),
+		NewPeriod(time.Unix(30, 0), time.Unix(40, 0)),
+	}, ps.Dedupe())
+	assert.Equal(t, PeriodSlice{
+		NewBoundedPeriod(time.Unix(10, 0), time.Unix(20, 0), ClosedClosed),
+		NewPeriod(time.Unix(30, 0), time.Unix(40, 0)),
+	}, ps.Merge())
+	assert.Equal(t, original, ps)
+
+	unbounded := PeriodSlice{
+		NewPeriod(time.Unix(10, 0), time.Time{}),
+		NewBoundedPeriod(time.Unix(10, 0), time.Time{}, ClosedClosed),
+		NewPeriod(time.Unix(10, 0), time.Time{}),
+	}
+	assert.Equal(t, PeriodSlice{NewPeriod(time.Unix(10, 0), time.Time{})}, unbounded.Dedupe())
+}
+
+func TestPeriodSlice_Collection(t *testing.T) {
+	ps := PeriodSlice{
+		NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+		NewPeriod(time.Unix(15, 0), time.Time{}),
+	}
+	pc := ps.Collection()
+	assert.Equal(t, []Period{ps[0], ps[1]}, pc.ContainsTime(time.Unix(16, 0)))
+	contents, err := pc.ContentsOfKey(1)
+	assert.NoError(t, err)
+	assert.Equal(t, ps[1], contents)
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// emptyRange is the PostgreSQL range literal for a range that contains no times
+const emptyRange = "empty"
+
+// rangeTimestampLayouts are the layouts accepted for the start and end of a PostgreSQL range literal, including the
+// layouts PostgreSQL uses to output timestamps with a time zone offset in hours, minutes or seconds
+var rangeTimestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07:00:00",
+	time.RFC3339Nano,
+}
+
+// Value implements driver.Valuer, converting the Period into a PostgreSQL tstzrange literal such as
+// ["2019-01-01T09:00:00Z","2019-01-01T11:00:00Z"). An unbounded start or end is written as an infinite bound, and a
+// Period that contains no time is written as empty.
+func (p Period) Value() (driver.Value, error) {
+	if p.Bounds > OpenClosed {
+		return nil, fmt.Errorf("invalid bounds %d", uint8(p.Bounds))
+	}
+	if p.empty() {
+		return emptyRange, nil
+	}
+	var b strings.Builder
+	if p.Start.IsZero() || !p.Bounds.StartInclusive() {
+		b.WriteByte('(')
+	} else {
+		b.WriteByte('[')
+	}
+	if !p.Start.IsZero() {
+		b.WriteString(`"` + p.Start.Format(time.RFC3339Nano) + `"`)
+	}
+	b.WriteByte(',')
+	if !p.End.IsZero() {
+		b.WriteString(`"` + p.End.Format(time.RFC3339Nano) + `"`)
+	}
+	if p.End.IsZero() || !p.Bounds.EndInclusive() {
+		b.WriteByte(')')
+	} else {
+		b.WriteByte(']')
+	}
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner, reading a PostgreSQL tstzrange literal into the Period. An infinite start or end,
+// including one of -infinity or infinity, is read as a zero start or end time. Because a Period that is unbounded on
+// a side has no time to include or exclude, the inclusivity of an infinite bound is ignored and the Period keeps the
+// default inclusivity for that side.
+//
+// The range empty has no start or end time, so it is always read as NewPeriod(time.Unix(0, 0).UTC(),
+// time.Unix(0, 0).UTC()), a Period that starts and ends at the Unix epoch and contains no time. PostgreSQL normalizes
+// every range that contains no time to empty, so this is the only Period without time that Scan returns, and Value
+// writes it, like any other Period that contains no time, back as empty.
+func (p *Period) Scan(src any) error {
+	var literal string
+	switch v := src.(type) {
+	case string:
+		literal = v
+	case []byte:
+		literal = string(v)
+	case nil:
+		return fmt.Errorf("cannot scan NULL into a Period")
+	default:
+		return fmt.Errorf("cannot scan %T into a Period", src)
+	}
+	literal = strings.TrimSpace(literal)
+	if strings.EqualFold(literal, emptyRange) {
+		epoch := time.Unix(0, 0).UTC()
+		*p = NewPeriod(epoch, epoch)
+		return nil
+	}
+	if len(literal) < 3 || !strings.ContainsAny(literal[:1], "[(") || !strings.ContainsAny(literal[len(literal)-1:], "])") {
+		return fmt.Errorf("invalid range %q", literal)
+	}
+	startText, endText, found := strings.Cut(literal[1:len(literal)-1], ",")
+	if !found {
+		return fmt.Errorf("invalid range %q: missing ',' between start and end", literal)
+	}
+	var result Period
+	var err error
+	if result.Start, err = parseRangeBoundary(startText); err != nil {
+		return fmt.Errorf("invalid range %q: %w", literal, err)
+	}
+	if result.End, err = parseRangeBoundary(endText); err != nil {
+		return fmt.Errorf("invalid range %q: %w", literal, err)
+	}
+	result.Bounds = boundsOf(
+		result.Start.IsZero() || literal[0] == '[',
+		!result.End.IsZero() && literal[len(literal)-1] == ']',
+	)
+	*p = result
+	return nil
+}
+
+// parseRangeBoundary parses the start or end of a PostgreSQL range literal, returning the zero time if it is
+// infinite.
+func parseRangeBoundary(s string) (time.Time, error) {
+	s = strings.Trim(strings.TrimSpace(s), `"`)
+	switch strings.ToLower(s) {
+	case "", "infinity", "-infinity":
+		return time.Time{}, nil
+	}
+	for _, layout := range rangeTimestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", s)
+}
@@ -0,0 +1,154 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriod_Value(t *testing.T) {
+	tests := []struct {
+		expected    any
+		name        string
+		p           Period
+		expectError bool
+	}{
+		{
+			name:     "closed open period",
+			p:        NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+			expected: `["2019-01-01T09:00:00Z","2019-01-01T11:00:00Z")`,
+		}, {
+			name: "open closed period",
+			p: NewBoundedPeriod(
+				time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC), OpenClosed),
+			expected: `("2019-01-01T09:00:00Z","2019-01-01T11:00:00Z"]`,
+		}, {
+			name:     "unbounded start",
+			p:        NewPeriod(time.Time{}, time.Date(2019, 1, 1, 11, 0, 0, 0, time.FixedZone("", -5*60*60))),
+			expected: `(,"2019-01-01T11:00:00-05:00")`,
+		}, {
+			name:     "unbounded end",
+			p:        NewBoundedPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}, ClosedClosed),
+			expected: `["2019-01-01T09:00:00Z",)`,
+		}, {
+			name:     "empty period",
+			p:        NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC)),
+			expected: "empty",
+		}, {
+			name:        "invalid bounds",
+			p:           NewBoundedPeriod(time.Time{}, time.Time{}, Bounds(9)),
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.p.Value()
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestPeriod_Scan(t *testing.T) {
+	tests := []struct {
+		src         any
+		name        string
+		expected    Period
+		expectError bool
+	}{
+		{
+			name:     "PostgreSQL output with hour offset",
+			src:      `["2019-01-01 09:00:00+00","2019-01-01 11:00:00.5+00")`,
+			expected: NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 500000000, time.UTC)),
+		}, {
+			name: "PostgreSQL output with minute offset as bytes",
+			src:  []byte(`("2019-01-01 14:30:00+05:30","2019-01-01 16:30:00+05:30"]`),
+			expected: NewBoundedPeriod(
+				time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC), OpenClosed),
+		}, {
+			name:     "RFC 3339 timestamps",
+			src:      `["2019-01-01T09:00:00Z","2019-01-01T11:00:00Z")`,
+			expected: NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "infinite start",
+			src:      `(,"2019-01-01 11:00:00+00")`,
+			expected: NewPeriod(time.Time{}, time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "infinity timestamps",
+			src:      `[-infinity,infinity]`,
+			expected: Period{},
+		}, {
+			name:     "empty range",
+			src:      "empty",
+			expected: NewPeriod(time.Unix(0, 0), time.Unix(0, 0)),
+		}, {
+			name:        "NULL",
+			src:         nil,
+			expectError: true,
+		}, {
+			name:        "unsupported type",
+			src:         42,
+			expectError: true,
+		}, {
+			name:        "missing brackets",
+			src:         `"2019-01-01 09:00:00+00","2019-01-01 11:00:00+00"`,
+			expectError: true,
+		}, {
+			name:        "missing separator",
+			src:         `["2019-01-01 09:00:00+00"]`,
+			expectError: true,
+		}, {
+			name:        "invalid timestamp",
+			src:         `["yesterday",)`,
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var result Period
+			err := result.Scan(test.src)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
+
+func TestPeriod_ValueScanRoundTrip(t *testing.T) {
+	periods := []Period{
+		NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		NewBoundedPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC), OpenOpen),
+		NewPeriod(time.Time{}, time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}),
+	}
+	for _, p := range periods {
+		value, err := p.Value()
+		require.NoError(t, err)
+		var result Period
+		require.NoError(t, result.Scan(value))
+		assert.True(t, p.Equals(result), "expected %v, got %v", p, result)
+	}
+}
@@ -28,10 +28,15 @@ const (
 	DaysInWeek = 7
 )
 
-// Period defines a block of time bounded by a start and end.
+// maxDuration is the largest representable time.Duration, used as the length of unbounded periods
+const maxDuration time.Duration = 1<<63 - 1
+
+// Period defines a block of time bounded by a start and end. Bounds determines whether the start and end times
+// themselves are part of the period; the zero value includes the start but not the end.
 type Period struct {
-	Start time.Time `json:"start"`
-	End   time.Time `json:"end"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+	Bounds Bounds    `json:"bounds,omitempty"`
 }
 
 // RecurringPeriod defines an interface for converting periods that represent abstract points in time
@@ -66,50 +71,121 @@ func NewPeriod(start, end time.Time) Period {
 	}
 }
 
+// NewBoundedPeriod constructs a new time period from start and end times with the given bounds
+func NewBoundedPeriod(start, end time.Time, bounds Bounds) Period {
+	return Period{
+		Start:  start,
+		End:    end,
+		Bounds: bounds,
+	}
+}
+
+// PeriodConstructionError is the error type returned if a Period is invalid
+type PeriodConstructionError string
+
+// Error implements the error interface for PeriodConstructionError
+func (e PeriodConstructionError) Error() string {
+	return string(e)
+}
+
+// NewValidPeriod constructs a new time period from start and end times, returning an error if the period is not
+// valid. See Validate for the rules a valid period must follow.
+func NewValidPeriod(start, end time.Time) (Period, error) {
+	p := NewPeriod(start, end)
+	if err := p.Validate(); err != nil {
+		return Period{}, err
+	}
+	return p, nil
+}
+
+// Validate returns a PeriodConstructionError if the Period is not valid. A Period is valid if its start is not after
+// its end and its Bounds are one of the defined Bounds values. Periods that are unbounded on either end are never
+// invalid because of their start or end times.
+func (p Period) Validate() error {
+	if !p.Start.IsZero() && !p.End.IsZero() && p.Start.After(p.End) {
+		return PeriodConstructionError(fmt.Sprintf("period start %v is after end %v", p.Start, p.End))
+	}
+	if p.Bounds > OpenClosed {
+		return PeriodConstructionError(fmt.Sprintf("invalid period bounds %d", uint8(p.Bounds)))
+	}
+	return nil
+}
+
+// Valid returns whether the Period is valid. See Validate for the rules a valid period must follow.
+func (p Period) Valid() bool {
+	return p.Validate() == nil
+}
+
+// WithBounds returns a copy of the Period with the given bounds.
+func (p Period) WithBounds(bounds Bounds) Period {
+	p.Bounds = bounds
+	return p
+}
+
 // Intersects returns true if the other time period intersects the Period upon
 // which the method was called. Note that if the period's end time is the zero value, it is treated as if
-// the time period is unbounded on the end.
+// the time period is unbounded on the end. Whether periods that share only an end point intersect depends on
+// the Bounds of both periods.
 func (p Period) Intersects(other Period) bool {
-	if p.End.IsZero() && !other.End.IsZero() {
-		return p.Start.Before(other.End)
+	_, ok := p.Intersection(other)
+	return ok
+}
+
+// Intersection returns the period of time shared by the Period upon which the method was called and the other
+// period, and whether or not the two periods intersect at all. Like Intersects, a zero-valued start or end is treated
+// as unbounded on that side, so the intersection of two periods that are both unbounded on the end is also unbounded
+// on the end. The bounds of the result are taken from whichever period starts last and whichever period ends first.
+func (p Period) Intersection(other Period) (Period, bool) {
+	startPeriod, endPeriod := p, p
+	if compareStarts(other, p) > 0 {
+		startPeriod = other
 	}
-	if !p.End.IsZero() && other.End.IsZero() {
-		return other.Start.Before(p.End)
+	if compareEnds(other, p) < 0 {
+		endPeriod = other
 	}
-	// Calculate max(starts) < min(ends)
-	return MaxTime(p.Start, other.Start).Before(MinTime(p.End, other.End))
+	if !startsBeforeEnd(startPeriod, endPeriod) {
+		return Period{}, false
+	}
+	bounds := boundsOf(startPeriod.Bounds.StartInclusive(), endPeriod.Bounds.EndInclusive())
+	return NewBoundedPeriod(startPeriod.Start, endPeriod.End, bounds), true
 }
 
-// Contains returns true if the other time period is contained within the Period
-// upon which the method was called. The time period is treated as inclusive on both ends
-// eg [p.Start, p.End]
-func (p Period) Contains(other Period) bool {
-	if p.Start.IsZero() && p.End.IsZero() {
-		return true
+// IntersectAll returns the period of time shared by all of the given periods and whether or not such a period
+// exists. Calling IntersectAll with no periods returns false.
+func IntersectAll(periods ...Period) (Period, bool) {
+	if len(periods) == 0 {
+		return Period{}, false
 	}
-	s := p.Start.Before(other.Start) || p.Start.Equal(other.Start)
-	e := p.End.After(other.End) || p.End.Equal(other.End)
-	if p.Start.IsZero() && !p.End.IsZero() {
-		return e
-	} else if !p.Start.IsZero() && p.End.IsZero() {
-		return s
+	result := periods[0]
+	for _, period := range periods[1:] {
+		var ok bool
+		if result, ok = result.Intersection(period); !ok {
+			return Period{}, false
+		}
+	}
+	if result.empty() {
+		return Period{}, false
 	}
-	return s && e
+	return result, true
+}
+
+// Contains returns true if the other time period is contained within the Period
+// upon which the method was called. Periods with the same bounds that share a start or end time contain
+// one another, eg [p.Start, p.End) contains [p.Start, p.End), but [p.Start, p.End) does not contain
+// [p.Start, p.End].
+func (p Period) Contains(other Period) bool {
+	return compareStarts(p, other) <= 0 && compareEnds(p, other) >= 0
 }
 
 // ContainsAny returns true if the other time periods start or end is contained within the Period
-// upon which the method was called.
+// upon which the method was called. An end that is the same as the end of the Period is only contained if the Period
+// includes its end, so a period ending at the end of a period with the default bounds is not contained.
 func (p Period) ContainsAny(other Period) bool {
-	if p.Start.IsZero() {
-		// If the start period is "empty" anything before our end time is contained
-		return p.End.After(other.Start)
-	} else if p.End.IsZero() {
-		// If the end period is "empty" anything after or including our start time is contained
-		return p.Start.Before(other.Start) || p.Start.Equal(other.Start)
-	}
-	// Otherwise, check for inclusion on start and ends times
-	s := (p.Start.Before(other.Start) || p.Start.Equal(other.Start)) && p.End.After(other.Start)
-	e := p.Start.Before(other.End) && p.End.After(other.End)
+	// The other period's start is contained if it begins no earlier than p and before p ends
+	s := compareStarts(p, other) <= 0 && startsBeforeEnd(other, p)
+	// The other period's end is contained if it ends before p ends, or with p if p includes its end, and after p begins
+	c := compareEnds(other, p)
+	e := (c < 0 || (c == 0 && (p.End.IsZero() || p.Bounds.EndInclusive()))) && startsBeforeEnd(p, other)
 	return s || e
 }
 
@@ -118,32 +194,28 @@ func (p Period) Less(d time.Duration) bool {
 	return p.End.Sub(p.Start) < d
 }
 
-// ContainsTime determines if the Period contains the specified time.
-func (p Period) ContainsTime(t time.Time, endInclusive bool) bool {
-	if p.Start.IsZero() && p.End.IsZero() {
-		return true
-	} else if !p.Start.IsZero() && p.End.IsZero() {
-		return p.Start.Before(t) || p.Start.Equal(t)
-	} else if p.Start.IsZero() && !p.End.IsZero() {
-		if endInclusive {
-			return p.End.After(t) || p.End.Equal(t)
-		}
-
-		return p.End.After(t)
-	}
-
-	if endInclusive {
-		return (p.Start.Before(t) || p.Start.Equal(t)) && (p.End.After(t) || p.End.Equal(t))
+// Duration returns the length of the period. Periods that are unbounded on either end return the maximum
+// representable duration.
+func (p Period) Duration() time.Duration {
+	if p.Start.IsZero() || p.End.IsZero() {
+		return maxDuration
 	}
+	return p.End.Sub(p.Start)
+}
 
-	return (p.Start.Before(t) || p.Start.Equal(t)) && p.End.After(t)
+// ContainsTime determines if the Period contains the specified time. The end time is contained if either
+// endInclusive is true or the period's Bounds include the end.
+func (p Period) ContainsTime(t time.Time, endInclusive bool) bool {
+	afterStart := p.Start.IsZero() || p.Start.Before(t) || (p.Start.Equal(t) && p.Bounds.StartInclusive())
+	beforeEnd := p.End.IsZero() || p.End.After(t) || (p.End.Equal(t) && (endInclusive || p.Bounds.EndInclusive()))
+	return afterStart && beforeEnd
 }
 
-// Equals returns whether or not two periods represent the same timespan. Periods are equal if their start time
-// and end times are the same, even if they are located in different timezones. For example a period from 12:00 - 17:00
-// UTC and a period from 7:00 - 12:00 UTC-5 on the same day are considered equal.
+// Equals returns whether or not two periods represent the same timespan. Periods are equal if their start time,
+// end times and bounds are the same, even if they are located in different timezones. For example a period from
+// 12:00 - 17:00 UTC and a period from 7:00 - 12:00 UTC-5 on the same day are considered equal.
 func (p Period) Equals(other Period) bool {
-	return p.Start.Equal(other.Start) && p.End.Equal(other.End)
+	return p.Start.Equal(other.Start) && p.End.Equal(other.End) && p.Bounds == other.Bounds
 }
 
 // IsZero returns whether the period encompasses no time; in other words, the time difference between the start and end
@@ -158,19 +230,22 @@ func (p Period) IsZero() bool {
 // * the periods intersect but are not fully overlapping - the slice will contain the subset of p that is not contained in other.
 // * p fully envelops other - the slice will contain 2 elements: the subsets of p before/after other.
 // * other fully envelops p - the slice will be empty
+//
+// The bounds of each segment are chosen so that the time at which other begins or ends is kept in the result
+// exactly when other does not include it.
 func (p Period) Difference(other Period) []Period {
 	result := make([]Period, 0)
-	if p.Start.Before(other.Start) {
-		var end time.Time
-		if p.End.IsZero() {
-			end = other.Start
-		} else {
-			end = MinTime(p.End, other.Start)
+	if !other.Start.IsZero() {
+		before := NewBoundedPeriod(time.Time{}, other.Start, boundsOf(true, !other.Bounds.StartInclusive()))
+		if segment, ok := p.Intersection(before); ok {
+			result = append(result, segment)
 		}
-		result = append(result, NewPeriod(p.Start, end))
 	}
-	if (p.End.After(other.End) || p.End.IsZero()) && !other.End.IsZero() {
-		result = append(result, NewPeriod(MaxTime(p.Start, other.End), p.End))
+	if !other.End.IsZero() {
+		after := NewBoundedPeriod(other.End, time.Time{}, boundsOf(!other.Bounds.EndInclusive(), false))
+		if segment, ok := p.Intersection(after); ok {
+			result = append(result, segment)
+		}
 	}
 	return result
 }
@@ -274,27 +349,73 @@ func NewApplicableDaysMonStart(startDay int, endDay int) ApplicableDays {
 	return *applicableDays
 }
 
-// MergePeriods accepts an array of time periods and will return a new list with intersecting periods merged together
+// MergeOptions configures how MergePeriodsWithOptions merges periods. The zero MergeOptions merges periods in the
+// same way as MergePeriods.
+type MergeOptions struct {
+	// Adjacent merges periods where one begins exactly where another ends, even if the bounds of neither period
+	// include the time at which they meet.
+	Adjacent bool
+	// Gap merges periods that are separated by less than the gap, so that the merged period also covers the time
+	// between them.
+	Gap time.Duration
+	// MinDuration drops merged periods that are shorter than the minimum duration. Periods that are unbounded in
+	// either direction are never dropped.
+	MinDuration time.Duration
+}
+
+// MergePeriods accepts an array of time periods and will return a new list with intersecting periods merged together.
+// Periods that begin exactly where another ends are merged as long as the time between them is covered by the bounds
+// of either period. The supplied array is not modified.
 func MergePeriods(periods []Period) []Period {
-	sort.Slice(periods, func(i, j int) bool {
-		return periods[i].Start.Before(periods[j].Start)
+	return MergePeriodsWithOptions(periods, MergeOptions{})
+}
+
+// MergePeriodsWithOptions accepts an array of time periods and will return a new list, sorted by start time, with
+// intersecting periods merged together, along with any periods that the options allow to be merged. A period that is
+// unbounded on the end absorbs every period that begins after it. The supplied array is not modified.
+func MergePeriodsWithOptions(periods []Period, opts MergeOptions) []Period {
+	sorted := make([]Period, len(periods))
+	copy(sorted, periods)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return compareStarts(sorted[i], sorted[j]) < 0
 	})
-	merged := make([]Period, 0, len(periods))
-	for _, period := range periods {
+	merged := make([]Period, 0, len(sorted))
+	for _, period := range sorted {
 		// If the merged array is empty, simply add the current period and skip to the next iteration
 		if len(merged) == 0 {
 			merged = append(merged, period)
 			continue
 		}
-		// If the last merged period does not intersect the current period, add the current period to the merged
-		// array. If they DO intersect, merge the periods by updating the end time of the last merged period.
-		if merged[len(merged)-1].End.Before(period.Start) {
+		// If the last merged period does not connect to the current period, add the current period to the merged
+		// array. If they DO connect, merge the periods by updating the end time of the last merged period.
+		last := &merged[len(merged)-1]
+		if !opts.connects(*last, period) {
 			merged = append(merged, period)
-		} else {
-			merged[len(merged)-1].End = MaxTime(merged[len(merged)-1].End, period.End)
+		} else if compareEnds(period, *last) > 0 {
+			last.End = period.End
+			last.Bounds = boundsOf(last.Bounds.StartInclusive(), period.Bounds.EndInclusive())
 		}
 	}
-	return merged
+	if opts.MinDuration <= 0 {
+		return merged
+	}
+	kept := merged[:0]
+	for _, period := range merged {
+		if period.Duration() >= opts.MinDuration {
+			kept = append(kept, period)
+		}
+	}
+	return kept
+}
+
+// connects returns whether period b, which begins no earlier than period a, should be merged into period a.
+func (opts MergeOptions) connects(a, b Period) bool {
+	if connects(a, b) {
+		return true
+	}
+	// a is bounded on the end if it does not connect to b
+	gap := b.Start.Sub(a.End)
+	return (opts.Adjacent && gap == 0) || gap < opts.Gap
 }
 
 // AddDSTAwareDuration will add the given duration to the given time, adjusting for timezone offset changes due to DST and return
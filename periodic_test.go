@@ -23,6 +23,58 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestNewValidPeriod(t *testing.T) {
+	tests := []struct {
+		start, end  time.Time
+		name        string
+		expectError bool
+	}{
+		{
+			name:  "start before end is valid",
+			start: time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC),
+			end:   time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC),
+		}, {
+			name:  "start equal to end is valid",
+			start: time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC),
+			end:   time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC),
+		}, {
+			name:  "unbounded start is valid",
+			start: time.Time{},
+			end:   time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC),
+		}, {
+			name:        "start after end is invalid",
+			start:       time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC),
+			end:         time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC),
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := NewValidPeriod(test.start, test.end)
+			if test.expectError {
+				assert.IsType(t, PeriodConstructionError(""), err)
+				assert.Equal(t, Period{}, p)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, NewPeriod(test.start, test.end), p)
+		})
+	}
+}
+
+func TestPeriod_Validate(t *testing.T) {
+	valid := NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC))
+	assert.NoError(t, valid.Validate())
+	assert.True(t, valid.Valid())
+	invalidBounds := valid.WithBounds(Bounds(9))
+	assert.IsType(t, PeriodConstructionError(""), invalidBounds.Validate())
+	assert.False(t, invalidBounds.Valid())
+	inverted := NewPeriod(valid.End, valid.Start)
+	assert.EqualError(
+		t, inverted.Validate(), "period start 2019-01-01 11:00:00 +0000 UTC is after end 2019-01-01 09:00:00 +0000 UTC")
+	assert.False(t, inverted.Valid())
+}
+
 func TestPeriod_Intersects(t *testing.T) {
 	p := Period{
 		Start: time.Date(2018, 5, 25, 13, 14, 15, 0, time.UTC),
@@ -80,6 +132,24 @@ func TestPeriod_Intersects(t *testing.T) {
 			name: "False when other end is unbounded and other start comes after end",
 			p:    p,
 			o:    NewPeriod(p.End.Add(time.Second), time.Time{}),
+		}, {
+			name:           "True when both ends are unbounded",
+			expectedResult: true,
+			p:              NewPeriod(p.Start, time.Time{}),
+			o:              NewPeriod(p.End, time.Time{}),
+		}, {
+			name: "False when other starts at end",
+			p:    p,
+			o:    NewPeriod(p.End, p.End.Add(time.Minute)),
+		}, {
+			name:           "True when closed periods share an end point",
+			expectedResult: true,
+			p:              p.WithBounds(ClosedClosed),
+			o:              NewBoundedPeriod(p.End, p.End.Add(time.Minute), ClosedClosed),
+		}, {
+			name: "False when closed period starts at open period end",
+			p:    p.WithBounds(OpenOpen),
+			o:    NewBoundedPeriod(p.End, p.End.Add(time.Minute), ClosedClosed),
 		},
 	}
 	for _, test := range tests {
@@ -89,6 +159,114 @@ func TestPeriod_Intersects(t *testing.T) {
 	}
 }
 
+func TestPeriod_Intersection(t *testing.T) {
+	tests := []struct {
+		name             string
+		p, o             Period
+		expected         Period
+		expectIntersects bool
+	}{
+		{
+			name:             "overlapping periods return the overlap",
+			p:                NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			o:                NewPeriod(time.Unix(30, 0), time.Unix(70, 0)),
+			expected:         NewPeriod(time.Unix(30, 0), time.Unix(50, 0)),
+			expectIntersects: true,
+		}, {
+			name:             "contained period is returned",
+			p:                NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			o:                NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+			expected:         NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+			expectIntersects: true,
+		}, {
+			name: "periods sharing only an end point do not intersect",
+			p:    NewPeriod(time.Unix(10, 0), time.Unix(30, 0)),
+			o:    NewPeriod(time.Unix(30, 0), time.Unix(50, 0)),
+		}, {
+			name: "disjoint periods do not intersect",
+			p:    NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+			o:    NewPeriod(time.Unix(30, 0), time.Unix(50, 0)),
+		}, {
+			name:             "unbounded end takes the other period's end",
+			p:                NewPeriod(time.Unix(10, 0), time.Time{}),
+			o:                NewPeriod(time.Unix(5, 0), time.Unix(50, 0)),
+			expected:         NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			expectIntersects: true,
+		}, {
+			name:             "unbounded start takes the other period's start",
+			p:                NewPeriod(time.Time{}, time.Unix(40, 0)),
+			o:                NewPeriod(time.Unix(5, 0), time.Unix(50, 0)),
+			expected:         NewPeriod(time.Unix(5, 0), time.Unix(40, 0)),
+			expectIntersects: true,
+		}, {
+			name:             "two periods unbounded on the end intersect with an unbounded end",
+			p:                NewPeriod(time.Unix(10, 0), time.Time{}),
+			o:                NewPeriod(time.Unix(20, 0), time.Time{}),
+			expected:         NewPeriod(time.Unix(20, 0), time.Time{}),
+			expectIntersects: true,
+		}, {
+			name:             "fully unbounded period returns the other period",
+			p:                NewPeriod(time.Time{}, time.Time{}),
+			o:                NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+			expected:         NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+			expectIntersects: true,
+		}, {
+			name: "unbounded end starting after other end does not intersect",
+			p:    NewPeriod(time.Unix(60, 0), time.Time{}),
+			o:    NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, ok := test.p.Intersection(test.o)
+			assert.Equal(t, test.expectIntersects, ok)
+			assert.Equal(t, test.expected, result)
+			assert.Equal(t, ok, test.p.Intersects(test.o))
+		})
+	}
+}
+
+func TestIntersectAll(t *testing.T) {
+	tests := []struct {
+		expected         Period
+		name             string
+		periods          []Period
+		expectIntersects bool
+	}{
+		{
+			name: "no periods do not intersect",
+		}, {
+			name:             "single period is returned",
+			periods:          []Period{NewPeriod(time.Unix(10, 0), time.Unix(50, 0))},
+			expected:         NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			expectIntersects: true,
+		}, {
+			name: "common overlap of all periods is returned",
+			periods: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+				NewPeriod(time.Unix(20, 0), time.Time{}),
+				NewPeriod(time.Time{}, time.Unix(40, 0)),
+			},
+			expected:         NewPeriod(time.Unix(20, 0), time.Unix(40, 0)),
+			expectIntersects: true,
+		}, {
+			name: "pairwise overlapping periods without a common overlap do not intersect",
+			periods: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(30, 0)),
+				NewPeriod(time.Unix(20, 0), time.Unix(50, 0)),
+				NewPeriod(time.Unix(40, 0), time.Unix(60, 0)),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, ok := IntersectAll(test.periods...)
+			assert.Equal(t, test.expectIntersects, ok)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
 func TestPeriod_Contains(t *testing.T) {
 	testTime1, err := time.Parse(time.RFC3339, "2018-05-25T13:14:15Z")
 	require.NoError(t, err)
@@ -149,6 +327,15 @@ func TestPeriod_Contains(t *testing.T) {
 			expectedResult: true,
 			p:              NewPeriod(time.Time{}, time.Time{}),
 			o:              NewPeriod(testTime1, testTime2),
+		}, {
+			name: "False when other includes an end that the period excludes",
+			p:    p,
+			o:    NewBoundedPeriod(testTime1, testTime2, ClosedClosed),
+		}, {
+			name:           "True when period includes both ends of an identical open period",
+			expectedResult: true,
+			p:              p.WithBounds(ClosedClosed),
+			o:              NewBoundedPeriod(testTime1, testTime2, OpenOpen),
 		},
 	}
 	for _, test := range tests {
@@ -227,6 +414,38 @@ func TestPeriod_ContainsAny(t *testing.T) {
 			name: "False when open ends period end time is before requested time",
 			p:    poe,
 			o:    NewPeriod(poe.Start.AddDate(-1, 0, 0), poe.Start.Add(-time.Duration(1)*time.Minute)),
+		}, {
+			name:           "True when end is contained in open ends period",
+			expectedResult: true,
+			p:              poe,
+			o:              NewPeriod(poe.Start.Add(-time.Minute), poe.Start.Add(time.Minute)),
+		}, {
+			name: "False when other starts at the end of the period",
+			p:    p,
+			o:    NewPeriod(p.End, p.End.Add(time.Minute)),
+		}, {
+			name:           "True when closed other starts at the end of a closed period",
+			expectedResult: true,
+			p:              p.WithBounds(ClosedClosed),
+			o:              NewBoundedPeriod(p.End, p.End.Add(time.Minute), ClosedClosed),
+		}, {
+			name: "False when other ends at the start of the period",
+			p:    p,
+			o:    NewPeriod(p.Start.Add(-time.Minute), p.Start),
+		}, {
+			name: "False when other starts before and ends at the end of the period",
+			p:    NewPeriod(p.Start.Add(time.Minute), p.End),
+			o:    p,
+		}, {
+			name:           "True when closed other starts before and ends at the end of a closed period",
+			expectedResult: true,
+			p:              NewBoundedPeriod(p.Start.Add(time.Minute), p.End, ClosedClosed),
+			o:              p.WithBounds(ClosedClosed),
+		}, {
+			name:           "True when other ends at the end of an open ended period",
+			expectedResult: true,
+			p:              poe,
+			o:              NewPeriod(poe.Start.Add(-time.Minute), time.Time{}),
 		},
 	}
 	for _, test := range tests {
@@ -265,6 +484,33 @@ func TestPeriod_Less(t *testing.T) {
 	}
 }
 
+func TestPeriod_Duration(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        Period
+		expected time.Duration
+	}{
+		{
+			name:     "bounded period returns the time between start and end",
+			p:        NewPeriod(time.Unix(10, 0), time.Unix(70, 0)),
+			expected: time.Minute,
+		}, {
+			name:     "period unbounded on the end returns the maximum duration",
+			p:        NewPeriod(time.Unix(10, 0), time.Time{}),
+			expected: maxDuration,
+		}, {
+			name:     "period unbounded on the start returns the maximum duration",
+			p:        NewPeriod(time.Time{}, time.Unix(10, 0)),
+			expected: maxDuration,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.p.Duration())
+		})
+	}
+}
+
 func TestPeriod_ContainsTime(t *testing.T) {
 	tests := []struct {
 		p              Period
@@ -334,6 +580,15 @@ func TestPeriod_ContainsTime(t *testing.T) {
 			p:              NewPeriod(time.Date(2018, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2018, 1, 1, 21, 0, 0, 0, time.UTC)),
 			t:              time.Date(2018, 1, 1, 21, 0, 0, 0, time.UTC),
 			endInclusive:   true,
+		}, {
+			name:           "Closed period 01/01/2018 05:00-21:00, request for 21:00 is contained",
+			expectedResult: true,
+			p:              NewBoundedPeriod(time.Date(2018, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2018, 1, 1, 21, 0, 0, 0, time.UTC), ClosedClosed),
+			t:              time.Date(2018, 1, 1, 21, 0, 0, 0, time.UTC),
+		}, {
+			name: "Open period 01/01/2018 05:00-21:00, request for 05:00 is not contained",
+			p:    NewBoundedPeriod(time.Date(2018, 1, 1, 5, 0, 0, 0, time.UTC), time.Date(2018, 1, 1, 21, 0, 0, 0, time.UTC), OpenOpen),
+			t:    time.Date(2018, 1, 1, 5, 0, 0, 0, time.UTC),
 		},
 	}
 	for _, test := range tests {
@@ -561,6 +816,9 @@ func TestPeriod_Equals(t *testing.T) {
 			name:    "periods with the starts and ends in different times are the same when adjusted",
 			other:   NewPeriod(time.Date(2018, 12, 7, 6, 0, 0, 0, chiTz), time.Date(2018, 12, 7, 11, 0, 0, 0, chiTz)),
 			outcome: true,
+		}, {
+			name:  "periods with the same starts and ends and different bounds are not equal",
+			other: NewBoundedPeriod(time.Date(2018, 12, 7, 12, 0, 0, 0, time.UTC), time.Date(2018, 12, 7, 17, 0, 0, 0, time.UTC), ClosedClosed),
 		},
 	}
 	for _, test := range tests {
@@ -699,6 +957,52 @@ func TestMergePeriods(t *testing.T) {
 				NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
 				NewPeriod(time.Unix(70, 0), time.Unix(90, 0)),
 			},
+		}, {
+			"period unbounded on the end absorbs later periods",
+			[]Period{
+				NewPeriod(time.Unix(10, 0), time.Time{}),
+				NewPeriod(time.Unix(30, 0), time.Unix(50, 0)),
+			},
+			[]Period{
+				NewPeriod(time.Unix(10, 0), time.Time{}),
+			},
+		}, {
+			"period unbounded on the end extends an earlier overlapping period",
+			[]Period{
+				NewPeriod(time.Unix(30, 0), time.Time{}),
+				NewPeriod(time.Unix(10, 0), time.Unix(40, 0)),
+			},
+			[]Period{
+				NewPeriod(time.Unix(10, 0), time.Time{}),
+			},
+		}, {
+			"adjacent closed periods are merged",
+			[]Period{
+				NewBoundedPeriod(time.Unix(30, 0), time.Unix(50, 0), ClosedClosed),
+				NewBoundedPeriod(time.Unix(10, 0), time.Unix(30, 0), ClosedClosed),
+			},
+			[]Period{
+				NewBoundedPeriod(time.Unix(10, 0), time.Unix(50, 0), ClosedClosed),
+			},
+		}, {
+			"adjacent open periods are not merged",
+			[]Period{
+				NewBoundedPeriod(time.Unix(10, 0), time.Unix(30, 0), OpenOpen),
+				NewBoundedPeriod(time.Unix(30, 0), time.Unix(50, 0), OpenOpen),
+			},
+			[]Period{
+				NewBoundedPeriod(time.Unix(10, 0), time.Unix(30, 0), OpenOpen),
+				NewBoundedPeriod(time.Unix(30, 0), time.Unix(50, 0), OpenOpen),
+			},
+		}, {
+			"merged period takes the bounds of the latest end",
+			[]Period{
+				NewBoundedPeriod(time.Unix(10, 0), time.Unix(30, 0), OpenOpen),
+				NewBoundedPeriod(time.Unix(20, 0), time.Unix(50, 0), ClosedClosed),
+			},
+			[]Period{
+				NewBoundedPeriod(time.Unix(10, 0), time.Unix(50, 0), OpenClosed),
+			},
 		}, {
 			"single input period returns single output period",
 			[]Period{
@@ -717,6 +1021,98 @@ func TestMergePeriods(t *testing.T) {
 	}
 }
 
+func TestMergePeriods_DoesNotModifyInput(t *testing.T) {
+	periods := []Period{
+		NewPeriod(time.Unix(90, 0), time.Unix(110, 0)),
+		NewPeriod(time.Unix(50, 0), time.Unix(100, 0)),
+	}
+	MergePeriods(periods)
+	assert.Equal(t, []Period{
+		NewPeriod(time.Unix(90, 0), time.Unix(110, 0)),
+		NewPeriod(time.Unix(50, 0), time.Unix(100, 0)),
+	}, periods)
+}
+
+func TestMergePeriodsWithOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		periods  []Period
+		expected []Period
+		opts     MergeOptions
+	}{
+		{
+			name: "adjacent open periods are merged",
+			periods: []Period{
+				NewBoundedPeriod(time.Unix(30, 0), time.Unix(50, 0), OpenOpen),
+				NewBoundedPeriod(time.Unix(10, 0), time.Unix(30, 0), OpenOpen),
+			},
+			opts:     MergeOptions{Adjacent: true},
+			expected: []Period{NewBoundedPeriod(time.Unix(10, 0), time.Unix(50, 0), OpenOpen)},
+		}, {
+			name: "periods separated by less than the gap are merged",
+			periods: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(30, 0)),
+				NewPeriod(time.Unix(39, 0), time.Unix(50, 0)),
+				NewPeriod(time.Unix(60, 0), time.Unix(70, 0)),
+			},
+			opts: MergeOptions{Gap: 10 * time.Second},
+			expected: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+				NewPeriod(time.Unix(60, 0), time.Unix(70, 0)),
+			},
+		}, {
+			name: "gap merges adjacent open periods",
+			periods: []Period{
+				NewBoundedPeriod(time.Unix(10, 0), time.Unix(30, 0), OpenOpen),
+				NewBoundedPeriod(time.Unix(30, 0), time.Unix(50, 0), OpenOpen),
+			},
+			opts:     MergeOptions{Gap: time.Second},
+			expected: []Period{NewBoundedPeriod(time.Unix(10, 0), time.Unix(50, 0), OpenOpen)},
+		}, {
+			name: "merged periods shorter than the minimum duration are dropped",
+			periods: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(15, 0)),
+				NewPeriod(time.Unix(15, 0), time.Unix(20, 0)),
+				NewPeriod(time.Unix(30, 0), time.Unix(35, 0)),
+				NewPeriod(time.Unix(50, 0), time.Time{}),
+			},
+			opts: MergeOptions{MinDuration: 10 * time.Second},
+			expected: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+				NewPeriod(time.Unix(50, 0), time.Time{}),
+			},
+		}, {
+			name: "unbounded periods are kept",
+			periods: []Period{
+				NewPeriod(time.Unix(60, 0), time.Unix(70, 0)),
+				NewPeriod(time.Time{}, time.Unix(20, 0)),
+				NewPeriod(time.Unix(25, 0), time.Time{}),
+			},
+			opts: MergeOptions{Gap: time.Second},
+			expected: []Period{
+				NewPeriod(time.Time{}, time.Unix(20, 0)),
+				NewPeriod(time.Unix(25, 0), time.Time{}),
+			},
+		}, {
+			name: "unbounded periods are merged by the gap",
+			periods: []Period{
+				NewPeriod(time.Unix(25, 0), time.Time{}),
+				NewPeriod(time.Time{}, time.Unix(20, 0)),
+			},
+			opts:     MergeOptions{Gap: 10 * time.Second},
+			expected: []Period{{}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			periods := make([]Period, len(test.periods))
+			copy(periods, test.periods)
+			assert.Equal(t, test.expected, MergePeriodsWithOptions(periods, test.opts))
+			assert.Equal(t, test.periods, periods)
+		})
+	}
+}
+
 func TestAddDSTAwareDuration(t *testing.T) {
 	chiTz, err := time.LoadLocation("America/Chicago")
 	require.NoError(t, err)
@@ -843,6 +1239,27 @@ func TestPeriod_Difference(t *testing.T) {
 			NewPeriod(time.Unix(5, 0), time.Time{}),
 			NewPeriod(time.Unix(10, 0), time.Time{}),
 			[]Period{NewPeriod(time.Unix(5, 0), time.Unix(10, 0))},
+		}, {
+			"other start is zero",
+			NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			NewPeriod(time.Time{}, time.Unix(20, 0)),
+			[]Period{NewPeriod(time.Unix(20, 0), time.Unix(50, 0))},
+		}, {
+			"closed other bisects closed period",
+			NewBoundedPeriod(time.Unix(10, 0), time.Unix(50, 0), ClosedClosed),
+			NewBoundedPeriod(time.Unix(20, 0), time.Unix(30, 0), ClosedClosed),
+			[]Period{
+				NewBoundedPeriod(time.Unix(10, 0), time.Unix(20, 0), ClosedOpen),
+				NewBoundedPeriod(time.Unix(30, 0), time.Unix(50, 0), OpenClosed),
+			},
+		}, {
+			"open other bisects period",
+			NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			NewBoundedPeriod(time.Unix(20, 0), time.Unix(30, 0), OpenOpen),
+			[]Period{
+				NewBoundedPeriod(time.Unix(10, 0), time.Unix(20, 0), ClosedClosed),
+				NewBoundedPeriod(time.Unix(30, 0), time.Unix(50, 0), ClosedOpen),
+			},
 		},
 	}
 	for _, test := range tests {
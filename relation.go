@@ -0,0 +1,135 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"fmt"
+)
+
+// Relation is one of the 13 relations of Allen's interval algebra describing how one period is positioned relative
+// to another.
+type Relation int
+
+// The relations are declared so that every relation and its inverse are equidistant from RelationEquals.
+const (
+	// RelationBefore means the period ends before the other period starts
+	RelationBefore Relation = iota
+	// RelationMeets means the period ends exactly when the other period starts
+	RelationMeets
+	// RelationOverlaps means the period starts before the other period and ends during it
+	RelationOverlaps
+	// RelationStarts means both periods start together and the period ends first
+	RelationStarts
+	// RelationDuring means the period starts after and ends before the other period
+	RelationDuring
+	// RelationFinishes means both periods end together and the period starts last
+	RelationFinishes
+	// RelationEquals means both periods start and end together
+	RelationEquals
+	// RelationFinishedBy means both periods end together and the period starts first
+	RelationFinishedBy
+	// RelationContains means the period starts before and ends after the other period
+	RelationContains
+	// RelationStartedBy means both periods start together and the period ends last
+	RelationStartedBy
+	// RelationOverlappedBy means the period starts during the other period and ends after it
+	RelationOverlappedBy
+	// RelationMetBy means the period starts exactly when the other period ends
+	RelationMetBy
+	// RelationAfter means the period starts after the other period ends
+	RelationAfter
+)
+
+// Inverse returns the relation of the other period to the period; for example the inverse of RelationBefore is
+// RelationAfter.
+func (r Relation) Inverse() Relation {
+	return RelationAfter - r
+}
+
+// String returns the name of the relation.
+func (r Relation) String() string {
+	switch r {
+	case RelationBefore:
+		return "before"
+	case RelationMeets:
+		return "meets"
+	case RelationOverlaps:
+		return "overlaps"
+	case RelationStarts:
+		return "starts"
+	case RelationDuring:
+		return "during"
+	case RelationFinishes:
+		return "finishes"
+	case RelationEquals:
+		return "equals"
+	case RelationFinishedBy:
+		return "finished by"
+	case RelationContains:
+		return "contains"
+	case RelationStartedBy:
+		return "started by"
+	case RelationOverlappedBy:
+		return "overlapped by"
+	case RelationMetBy:
+		return "met by"
+	case RelationAfter:
+		return "after"
+	}
+	return fmt.Sprintf("Relation(%d)", int(r))
+}
+
+// Relate returns the Allen relation of the Period upon which the method was called to the other period. A zero
+// start or end time is treated as unbounded, so two periods that are both unbounded on the same side are considered
+// to start or end together. Relations are determined from the start and end times alone; Bounds are not considered.
+func (p Period) Relate(other Period) Relation {
+	// the bounds are not considered, so compare both periods as if they had the default bounds
+	p, other = p.WithBounds(ClosedOpen), other.WithBounds(ClosedOpen)
+	starts, ends := compareStarts(p, other), compareEnds(p, other)
+	if starts == 0 && ends == 0 {
+		return RelationEquals
+	}
+	// with the default bounds, a period does not start before the end of another if it starts at or after that end,
+	// in which case both times are bounded
+	if !startsBeforeEnd(other, p) {
+		if p.End.Equal(other.Start) {
+			return RelationMeets
+		}
+		return RelationBefore
+	}
+	if !startsBeforeEnd(p, other) {
+		if p.Start.Equal(other.End) {
+			return RelationMetBy
+		}
+		return RelationAfter
+	}
+	switch {
+	case starts == 0 && ends < 0:
+		return RelationStarts
+	case starts == 0:
+		return RelationStartedBy
+	case ends == 0 && starts > 0:
+		return RelationFinishes
+	case ends == 0:
+		return RelationFinishedBy
+	case starts > 0 && ends < 0:
+		return RelationDuring
+	case starts < 0 && ends > 0:
+		return RelationContains
+	case starts < 0:
+		return RelationOverlaps
+	}
+	return RelationOverlappedBy
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriod_Relate(t *testing.T) {
+	p := NewPeriod(time.Unix(20, 0), time.Unix(40, 0))
+	tests := []struct {
+		name     string
+		p, o     Period
+		expected Relation
+	}{
+		{
+			name:     "period ending before other starts is before",
+			p:        NewPeriod(time.Unix(5, 0), time.Unix(10, 0)),
+			o:        p,
+			expected: RelationBefore,
+		}, {
+			name:     "period ending when other starts meets",
+			p:        NewPeriod(time.Unix(5, 0), time.Unix(20, 0)),
+			o:        p,
+			expected: RelationMeets,
+		}, {
+			name:     "period ending during other overlaps",
+			p:        NewPeriod(time.Unix(5, 0), time.Unix(30, 0)),
+			o:        p,
+			expected: RelationOverlaps,
+		}, {
+			name:     "period starting with other and ending first starts",
+			p:        NewPeriod(time.Unix(20, 0), time.Unix(30, 0)),
+			o:        p,
+			expected: RelationStarts,
+		}, {
+			name:     "period strictly inside other is during",
+			p:        NewPeriod(time.Unix(25, 0), time.Unix(30, 0)),
+			o:        p,
+			expected: RelationDuring,
+		}, {
+			name:     "period ending with other and starting last finishes",
+			p:        NewPeriod(time.Unix(30, 0), time.Unix(40, 0)),
+			o:        p,
+			expected: RelationFinishes,
+		}, {
+			name:     "identical periods are equal",
+			p:        p,
+			o:        p,
+			expected: RelationEquals,
+		}, {
+			name:     "period ending with other and starting first is finished by",
+			p:        NewPeriod(time.Unix(10, 0), time.Unix(40, 0)),
+			o:        p,
+			expected: RelationFinishedBy,
+		}, {
+			name:     "period strictly around other contains",
+			p:        NewPeriod(time.Unix(10, 0), time.Unix(50, 0)),
+			o:        p,
+			expected: RelationContains,
+		}, {
+			name:     "period starting with other and ending last is started by",
+			p:        NewPeriod(time.Unix(20, 0), time.Unix(50, 0)),
+			o:        p,
+			expected: RelationStartedBy,
+		}, {
+			name:     "period starting during other is overlapped by",
+			p:        NewPeriod(time.Unix(30, 0), time.Unix(50, 0)),
+			o:        p,
+			expected: RelationOverlappedBy,
+		}, {
+			name:     "period starting when other ends is met by",
+			p:        NewPeriod(time.Unix(40, 0), time.Unix(50, 0)),
+			o:        p,
+			expected: RelationMetBy,
+		}, {
+			name:     "period starting after other ends is after",
+			p:        NewPeriod(time.Unix(45, 0), time.Unix(50, 0)),
+			o:        p,
+			expected: RelationAfter,
+		}, {
+			name:     "periods unbounded on the same end start together",
+			p:        NewPeriod(time.Unix(20, 0), time.Time{}),
+			o:        p,
+			expected: RelationStartedBy,
+		}, {
+			name:     "periods unbounded on the start start together",
+			p:        NewPeriod(time.Time{}, time.Unix(40, 0)),
+			o:        NewPeriod(time.Time{}, time.Unix(30, 0)),
+			expected: RelationStartedBy,
+		}, {
+			name:     "fully unbounded periods are equal",
+			p:        NewPeriod(time.Time{}, time.Time{}),
+			o:        NewPeriod(time.Time{}, time.Time{}),
+			expected: RelationEquals,
+		}, {
+			name:     "fully unbounded period contains bounded period",
+			p:        NewPeriod(time.Time{}, time.Time{}),
+			o:        p,
+			expected: RelationContains,
+		}, {
+			name:     "period unbounded on the start ending when other starts meets",
+			p:        NewPeriod(time.Time{}, time.Unix(20, 0)),
+			o:        p,
+			expected: RelationMeets,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.p.Relate(test.o))
+			assert.Equal(t, test.expected.Inverse(), test.o.Relate(test.p))
+		})
+	}
+}
+
+func TestRelation_String(t *testing.T) {
+	assert.Equal(t, "before", RelationBefore.String())
+	assert.Equal(t, "overlapped by", RelationOverlappedBy.String())
+	assert.Equal(t, "Relation(13)", Relation(13).String())
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"time"
+)
+
+// RoundingOptions configures how Period.Round, Period.RoundOut and Period.RoundIn round the start and end of a period.
+type RoundingOptions struct {
+	// Location, if set, aligns the granularity to the local wall clock of the location, so that hourly rounding
+	// rounds to the top of the local hour and daily rounding rounds to local midnight. Otherwise, times are rounded
+	// relative to the Unix epoch in UTC.
+	Location *time.Location
+	// Grace is the amount of time by which the period may extend past a boundary before RoundOut rounds it out to the
+	// next boundary. For example, with an hourly granularity and a 5 minute grace, a period ending at 10:05 is rounded
+	// out to end at 10:00 rather than 11:00. Grace is only used by RoundOut.
+	Grace time.Duration
+	// MinDuration is the minimum duration of the rounded period. A rounded period that is shorter is extended on the
+	// end to the first boundary at or after its start plus MinDuration.
+	MinDuration time.Duration
+}
+
+// Round rounds the start and end of the Period to the nearest multiple of the granularity. Times exactly halfway
+// between two boundaries are rounded up. Unbounded ends are left unbounded, and the rounded period keeps the Period's
+// bounds. A granularity that is not positive returns the Period unchanged.
+func (p Period) Round(granularity time.Duration, opts RoundingOptions) Period {
+	return p.round(granularity, opts, grid.nearest, grid.nearest)
+}
+
+// RoundOut rounds the start of the Period down and the end of the Period up to a multiple of the granularity, so
+// that the rounded period contains the Period, except where the Period extends past a boundary by no more than
+// opts.Grace. A Period that is not empty is never rounded out to an empty period, so a Period shorter than the grace
+// that would otherwise collapse onto a single boundary is rounded out to the granule that follows that boundary.
+// Unbounded ends are left unbounded, and the rounded period keeps the Period's bounds. A granularity that is not
+// positive returns the Period unchanged.
+func (p Period) RoundOut(granularity time.Duration, opts RoundingOptions) Period {
+	roundStart := func(g grid, t time.Time) time.Time {
+		if hi := g.ceil(t); !hi.After(t.Add(opts.Grace)) {
+			return hi
+		}
+		return g.floor(t)
+	}
+	roundEnd := func(g grid, t time.Time) time.Time {
+		if lo := g.floor(t); !t.After(lo.Add(opts.Grace)) {
+			return lo
+		}
+		return g.ceil(t)
+	}
+	rounded := p.round(granularity, opts, roundStart, roundEnd)
+	if granularity > 0 && !p.empty() && !rounded.Start.IsZero() && !rounded.End.IsZero() &&
+		!rounded.End.After(rounded.Start) {
+		rounded.End = epochGrid(granularity, opts.Location).ceil(rounded.Start.Add(time.Nanosecond))
+	}
+	return rounded
+}
+
+// RoundIn rounds the start of the Period up and the end of the Period down to a multiple of the granularity, so that
+// the rounded period is contained by the Period. If the Period does not contain a full granule, the rounded period
+// is empty, starting and ending at the rounded start. Unbounded ends are left unbounded, and the rounded period keeps
+// the Period's bounds. A granularity that is not positive returns the Period unchanged.
+func (p Period) RoundIn(granularity time.Duration, opts RoundingOptions) Period {
+	rounded := p.round(granularity, opts, grid.ceil, grid.floor)
+	if !rounded.Start.IsZero() && !rounded.End.IsZero() && rounded.End.Before(rounded.Start) {
+		rounded.End = rounded.Start
+	}
+	return rounded
+}
+
+// round is the internal implementation of the rounding methods, rounding the start and end of the Period with the
+// given functions and then applying the minimum duration.
+func (p Period) round(
+	granularity time.Duration, opts RoundingOptions, roundStart, roundEnd func(grid, time.Time) time.Time,
+) Period {
+	if granularity <= 0 {
+		return p
+	}
+	g := epochGrid(granularity, opts.Location)
+	rounded := p
+	if !p.Start.IsZero() {
+		rounded.Start = roundStart(g, p.Start)
+	}
+	if !p.End.IsZero() {
+		rounded.End = roundEnd(g, p.End)
+	}
+	if !rounded.Start.IsZero() && !rounded.End.IsZero() && rounded.Less(opts.MinDuration) {
+		rounded.End = g.ceil(rounded.Start.Add(opts.MinDuration))
+	}
+	return rounded
+}
+
+// epochGrid returns a grid with the given step that is anchored at the Unix epoch, on the local wall clock of the
+// location if one is given.
+func epochGrid(step time.Duration, loc *time.Location) grid {
+	if loc == nil {
+		return newGrid(time.Unix(0, 0).UTC(), step, nil)
+	}
+	return newGrid(time.Date(1970, time.January, 1, 0, 0, 0, 0, loc), step, loc)
+}
+
+// floor returns the last boundary of the grid at or before t.
+func (g grid) floor(t time.Time) time.Time {
+	g = g.near(t)
+	return g.boundary(g.index(t))
+}
+
+// ceil returns the first boundary of the grid at or after t.
+func (g grid) ceil(t time.Time) time.Time {
+	g = g.near(t)
+	k := g.index(t)
+	if floor := g.boundary(k); floor.Equal(t) {
+		return floor
+	}
+	return g.boundary(k + 1)
+}
+
+// nearest returns the boundary of the grid closest to t, preferring the later boundary if t is halfway between two.
+func (g grid) nearest(t time.Time) time.Time {
+	floor, ceil := g.floor(t), g.ceil(t)
+	if t.Sub(floor) < ceil.Sub(t) {
+		return floor
+	}
+	return ceil
+}
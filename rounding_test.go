@@ -0,0 +1,183 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriod_Round(t *testing.T) {
+	tests := []struct {
+		name        string
+		p           Period
+		expected    Period
+		opts        RoundingOptions
+		granularity time.Duration
+	}{
+		{
+			name:        "times are rounded to the nearest boundary",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 20, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 40, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "times halfway between boundaries are rounded up",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 30, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 30, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			expected:    NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "minimum duration extends the rounded period",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 20, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 25, 0, 0, time.UTC)),
+			granularity: 15 * time.Minute,
+			opts:        RoundingOptions{MinDuration: time.Hour},
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 15, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 15, 0, 0, time.UTC)),
+		}, {
+			name:        "unbounded ends are unchanged",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 20, 0, 0, time.UTC), time.Time{}),
+			granularity: time.Hour,
+			opts:        RoundingOptions{MinDuration: time.Hour},
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}),
+		}, {
+			name:        "times centuries from the epoch are rounded",
+			p:           NewPeriod(time.Date(1519, 1, 1, 9, 20, 0, 0, time.UTC), time.Date(2519, 1, 1, 10, 40, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			expected:    NewPeriod(time.Date(1519, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2519, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "non-positive granularity returns the period unchanged",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 20, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 40, 0, 0, time.UTC)),
+			granularity: 0,
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 20, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 40, 0, 0, time.UTC)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.p.Round(test.granularity, test.opts)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
+
+func TestPeriod_RoundOut(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	tests := []struct {
+		name        string
+		p           Period
+		expected    Period
+		opts        RoundingOptions
+		granularity time.Duration
+	}{
+		{
+			name:        "start is rounded down and end is rounded up",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 50, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 10, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "times on a boundary are unchanged",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "end within the grace period is not rounded up",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 5, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			opts:        RoundingOptions{Grace: 5 * time.Minute},
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "end beyond the grace period is rounded up",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 6, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			opts:        RoundingOptions{Grace: 5 * time.Minute},
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "start within the grace period is not rounded down",
+			p:           NewPeriod(time.Date(2019, 1, 1, 8, 57, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			opts:        RoundingOptions{Grace: 5 * time.Minute},
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "minimum duration applies after the grace period",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 3, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			opts:        RoundingOptions{Grace: 5 * time.Minute, MinDuration: time.Hour},
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "period shorter than the grace period is rounded out to a whole granule",
+			p:           NewPeriod(time.Date(2019, 1, 1, 10, 1, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 4, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			opts:        RoundingOptions{Grace: 5 * time.Minute},
+			expected:    NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "empty period is not rounded out",
+			p:           NewPeriod(time.Date(2019, 1, 1, 10, 1, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 1, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			opts:        RoundingOptions{Grace: 5 * time.Minute},
+			expected:    NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "daily granularity rounds to local midnight",
+			p:           NewPeriod(time.Date(2019, 3, 9, 12, 0, 0, 0, chiTz), time.Date(2019, 3, 10, 12, 0, 0, 0, chiTz)),
+			granularity: 24 * time.Hour,
+			opts:        RoundingOptions{Location: chiTz},
+			expected:    NewPeriod(time.Date(2019, 3, 9, 0, 0, 0, 0, chiTz), time.Date(2019, 3, 11, 0, 0, 0, 0, chiTz)),
+		}, {
+			name:        "bounds are kept",
+			p:           NewBoundedPeriod(time.Date(2019, 1, 1, 9, 50, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 10, 0, 0, time.UTC), ClosedClosed),
+			granularity: time.Hour,
+			expected:    NewBoundedPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC), ClosedClosed),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.p.RoundOut(test.granularity, test.opts)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
+
+func TestPeriod_RoundIn(t *testing.T) {
+	tests := []struct {
+		name        string
+		p           Period
+		expected    Period
+		opts        RoundingOptions
+		granularity time.Duration
+	}{
+		{
+			name:        "start is rounded up and end is rounded down",
+			p:           NewPeriod(time.Date(2019, 1, 1, 8, 50, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 10, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			expected:    NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "period without a full granule is empty",
+			p:           NewPeriod(time.Date(2019, 1, 1, 9, 10, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 50, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			expected:    NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+		}, {
+			name:        "unbounded start is unchanged",
+			p:           NewPeriod(time.Time{}, time.Date(2019, 1, 1, 9, 50, 0, 0, time.UTC)),
+			granularity: time.Hour,
+			expected:    NewPeriod(time.Time{}, time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.p.RoundIn(test.granularity, test.opts)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"time"
+)
+
+// Similarity scores how closely two timelines agree, such as a booking and the occupancy that a sensor recorded for
+// it. Durations that are unbounded are the maximum representable duration, or its negation for a delta towards an
+// unbounded start or end.
+type Similarity struct {
+	// Overlap is the amount of time covered by both timelines
+	Overlap time.Duration
+	// Jaccard is the overlap divided by the amount of time covered by either timeline, from 0 if the timelines do not
+	// overlap to 1 if they are the same
+	Jaccard float64
+	// OverlapCoefficient is the overlap divided by the duration of the shorter timeline, which is 1 if either timeline
+	// contains the other
+	OverlapCoefficient float64
+	// StartDelta is how much later the other timeline starts, which is negative if it starts earlier
+	StartDelta time.Duration
+	// EndDelta is how much later the other timeline ends, which is negative if it ends earlier
+	EndDelta time.Duration
+}
+
+// Similarity returns the Similarity of the Period and the other period.
+func (p Period) Similarity(other Period) Similarity {
+	return similarity([]Period{p}, []Period{other})
+}
+
+// Similarity returns the Similarity of the set and the other set, where the deltas are between the starts of the first
+// periods and the ends of the last periods of each set.
+func (ps PeriodSet) Similarity(other PeriodSet) Similarity {
+	return similarity(ps.periods, other.periods)
+}
+
+// SimilarityOfPeriods merges each of the given lists of periods with MergePeriods and returns the Similarity of the
+// results, where the deltas are between the starts of the first merged periods and the ends of the last merged
+// periods of each list. The given slices are not modified.
+func SimilarityOfPeriods(periods, other []Period) Similarity {
+	return similarity(MergePeriods(periods), MergePeriods(other))
+}
+
+// similarity returns the Similarity of two lists of merged periods sorted by start time.
+func similarity(a, b []Period) Similarity {
+	s := Similarity{Overlap: totalDuration(intersections(a, b))}
+	totalA, totalB := totalDuration(a), totalDuration(b)
+	union := addDurations(totalA, totalB)
+	if union != maxDuration {
+		union -= s.Overlap
+	}
+	s.Jaccard = durationRatio(s.Overlap, union)
+	s.OverlapCoefficient = durationRatio(s.Overlap, min(totalA, totalB))
+	if len(a) > 0 && len(b) > 0 {
+		s.StartDelta = timeDelta(a[0].Start, b[0].Start, -1)
+		s.EndDelta = timeDelta(a[len(a)-1].End, b[len(b)-1].End, 1)
+	}
+	return s
+}
+
+// totalDuration returns the sum of the durations of the periods.
+func totalDuration(periods []Period) time.Duration {
+	var total time.Duration
+	for _, p := range periods {
+		total = addDurations(total, p.Duration())
+	}
+	return total
+}
+
+// durationRatio returns the ratio of two durations, or 0 if the denominator is 0.
+func durationRatio(numerator, denominator time.Duration) float64 {
+	if denominator == 0 {
+		return 0
+	}
+	return float64(numerator) / float64(denominator)
+}
+
+// timeDelta returns the duration from time a to time b, where a zero time is unbounded in the direction of sign, which
+// is -1 for a start time and 1 for an end time.
+func timeDelta(a, b time.Time, sign time.Duration) time.Duration {
+	switch {
+	case a.IsZero() && b.IsZero():
+		return 0
+	case a.IsZero():
+		return -sign * maxDuration
+	case b.IsZero():
+		return sign * maxDuration
+	}
+	return b.Sub(a)
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriod_Similarity(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        Period
+		other    Period
+		expected Similarity
+	}{
+		{
+			name:  "partially overlapping periods",
+			p:     NewPeriod(time.Unix(0, 0), time.Unix(100, 0)),
+			other: NewPeriod(time.Unix(50, 0), time.Unix(200, 0)),
+			expected: Similarity{
+				Overlap:            50 * time.Second,
+				Jaccard:            0.25,
+				OverlapCoefficient: 0.5,
+				StartDelta:         50 * time.Second,
+				EndDelta:           100 * time.Second,
+			},
+		}, {
+			name:  "same periods",
+			p:     NewPeriod(time.Unix(0, 0), time.Unix(100, 0)),
+			other: NewPeriod(time.Unix(0, 0), time.Unix(100, 0)),
+			expected: Similarity{
+				Overlap:            100 * time.Second,
+				Jaccard:            1,
+				OverlapCoefficient: 1,
+			},
+		}, {
+			name:  "contained period",
+			p:     NewPeriod(time.Unix(0, 0), time.Unix(100, 0)),
+			other: NewPeriod(time.Unix(20, 0), time.Unix(40, 0)),
+			expected: Similarity{
+				Overlap:            20 * time.Second,
+				Jaccard:            0.2,
+				OverlapCoefficient: 1,
+				StartDelta:         20 * time.Second,
+				EndDelta:           -60 * time.Second,
+			},
+		}, {
+			name:  "disjoint periods",
+			p:     NewPeriod(time.Unix(0, 0), time.Unix(100, 0)),
+			other: NewPeriod(time.Unix(100, 0), time.Unix(150, 0)),
+			expected: Similarity{
+				StartDelta: 100 * time.Second,
+				EndDelta:   50 * time.Second,
+			},
+		}, {
+			name:  "unbounded periods",
+			p:     NewPeriod(time.Time{}, time.Unix(100, 0)),
+			other: NewPeriod(time.Unix(50, 0), time.Time{}),
+			expected: Similarity{
+				Overlap:            50 * time.Second,
+				Jaccard:            float64(50*time.Second) / float64(maxDuration),
+				OverlapCoefficient: float64(50*time.Second) / float64(maxDuration),
+				StartDelta:         maxDuration,
+				EndDelta:           maxDuration,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.p.Similarity(test.other))
+		})
+	}
+}
+
+func TestSimilarityOfPeriods(t *testing.T) {
+	booking := []Period{
+		NewPeriod(time.Unix(0, 0), time.Unix(100, 0)),
+		NewPeriod(time.Unix(200, 0), time.Unix(300, 0)),
+	}
+	occupancy := []Period{
+		NewPeriod(time.Unix(250, 0), time.Unix(320, 0)),
+		NewPeriod(time.Unix(10, 0), time.Unix(60, 0)),
+		NewPeriod(time.Unix(50, 0), time.Unix(90, 0)),
+	}
+	expected := Similarity{
+		Overlap:            130 * time.Second,
+		Jaccard:            130.0 / 220.0,
+		OverlapCoefficient: 130.0 / 150.0,
+		StartDelta:         10 * time.Second,
+		EndDelta:           20 * time.Second,
+	}
+	assert.Equal(t, expected, SimilarityOfPeriods(booking, occupancy))
+	assert.Equal(t, expected, NewPeriodSet(booking...).Similarity(NewPeriodSet(occupancy...)))
+	assert.Equal(t, Similarity{}, SimilarityOfPeriods(booking, nil))
+}
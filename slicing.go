@@ -0,0 +1,214 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"time"
+)
+
+// PartialSlicePolicy determines what happens to a slice at the start or end of a period that is shorter than a full
+// step because the period does not begin or end on a slice boundary.
+type PartialSlicePolicy int
+
+const (
+	// KeepPartial keeps the partial slice, truncated to the period
+	KeepPartial PartialSlicePolicy = iota
+	// DropPartial discards the partial slice
+	DropPartial
+	// RoundUpPartial extends the partial slice to a full step, even though it extends beyond the period
+	RoundUpPartial
+)
+
+// SliceOptions configures how Period.Slices divides a period.
+type SliceOptions struct {
+	// Location, if set, spaces slice boundaries evenly on the local wall clock of the location instead of in absolute
+	// time, so that hourly slices stay aligned to the top of the local hour across daylight saving time transitions.
+	Location *time.Location
+	// First is the policy for a partial slice at the start of the period
+	First PartialSlicePolicy
+	// Last is the policy for a partial slice at the end of the period
+	Last PartialSlicePolicy
+}
+
+// SliceIterator lazily produces the slices of a Period. Use Period.Slices to construct a SliceIterator.
+type SliceIterator struct {
+	period Period
+	grid   grid
+	opts   SliceOptions
+	next   int64
+	done   bool
+	// leading is set if the period is unbounded on the start and its leading slice has not been produced yet
+	leading bool
+}
+
+// Slices returns an iterator that divides the Period into consecutive slices of the given step, aligned so that slice
+// boundaries fall on the anchor time plus or minus a whole number of steps. Slices include their start but not their
+// end, except where a partial slice is truncated to the Period and keeps the Period's bounds.
+//
+// Slices are produced lazily, so a Period that is unbounded on the end produces an unending sequence of slices. A
+// Period that is unbounded on the start has no first boundary to slice from, so its first slice is a partial slice
+// from the unbounded start to the anchor, or the entire Period if it ends before the anchor; RoundUpPartial keeps
+// this slice as is. An empty period, a step that is not positive, or a zero anchor for a Period that is unbounded on
+// the start produces no slices. The anchor may be any distance from the Period.
+func (p Period) Slices(step time.Duration, anchor time.Time, opts SliceOptions) *SliceIterator {
+	it := &SliceIterator{
+		period: p,
+		grid:   newGrid(anchor, step, opts.Location),
+		opts:   opts,
+		// a zero anchor cannot end the leading slice of a period that is unbounded on the start, as it would be
+		// unbounded itself
+		done: step <= 0 || p.empty() || (p.Start.IsZero() && anchor.IsZero()),
+	}
+	if it.done {
+		return it
+	}
+	if p.Start.IsZero() {
+		it.leading = true
+	} else {
+		it.grid = it.grid.near(p.Start)
+		it.next = it.grid.index(p.Start)
+	}
+	return it
+}
+
+// Next returns the next slice of the period and true, or false if there are no more slices.
+func (it *SliceIterator) Next() (Period, bool) {
+	if it.leading {
+		it.leading = false
+		if !it.period.End.IsZero() && !it.period.End.After(it.grid.anchor) {
+			it.done = true
+			if it.opts.First == DropPartial || it.opts.Last == DropPartial {
+				return Period{}, false
+			}
+			return it.period, true
+		}
+		if it.opts.First != DropPartial {
+			return NewBoundedPeriod(time.Time{}, it.grid.anchor, ClosedOpen), true
+		}
+	}
+	for !it.done {
+		start, end := it.grid.boundary(it.next), it.grid.boundary(it.next+1)
+		it.next++
+		if !it.period.End.IsZero() && !start.Before(it.period.End) {
+			it.done = true
+			break
+		}
+		if !end.After(start) {
+			// the boundaries collapsed into one because they fall in a daylight saving time gap
+			continue
+		}
+		slice := NewPeriod(start, end)
+		if !it.period.Start.IsZero() && start.Before(it.period.Start) {
+			if it.opts.First == DropPartial {
+				continue
+			}
+			if it.opts.First == KeepPartial {
+				slice.Start = it.period.Start
+				slice.Bounds = boundsOf(it.period.Bounds.StartInclusive(), false)
+			}
+		}
+		if !it.period.End.IsZero() && !end.Before(it.period.End) {
+			it.done = true
+			if end.After(it.period.End) {
+				if it.opts.Last == DropPartial {
+					break
+				}
+				if it.opts.Last == RoundUpPartial {
+					return slice, true
+				}
+				slice.End = it.period.End
+			}
+			slice.Bounds = boundsOf(slice.Bounds.StartInclusive(), it.period.Bounds.EndInclusive())
+		}
+		return slice, true
+	}
+	return Period{}, false
+}
+
+// grid is a sequence of boundaries spaced evenly around an anchor time. If a location is set, the boundaries are
+// spaced evenly on the local wall clock rather than in absolute time.
+type grid struct {
+	anchor time.Time
+	// origin is the boundary from which the other boundaries are counted, which is the anchor, or the wall clock time
+	// of the anchor if a location is set. near moves it close to the times being looked up.
+	origin time.Time
+	loc    *time.Location
+	step   time.Duration
+}
+
+// newGrid constructs a new grid of boundaries spaced by step around the anchor.
+func newGrid(anchor time.Time, step time.Duration, loc *time.Location) grid {
+	g := grid{anchor: anchor, origin: anchor, loc: loc, step: step}
+	if loc != nil {
+		g.origin = wallClock(anchor, loc)
+	}
+	return g
+}
+
+// near returns the grid with its origin moved by a whole number of steps to the last boundary at or before t, so that
+// boundaries around t can be counted without overflowing a time.Duration when the anchor is centuries away from t.
+func (g grid) near(t time.Time) grid {
+	target := t
+	if g.loc != nil {
+		target = wallClock(t, g.loc)
+	}
+	// time.Time.Sub saturates beyond about 292 years, so first move the origin in chunks of whole steps
+	chunk := max((maxDuration/2/g.step)*g.step, g.step)
+	for d := target.Sub(g.origin); d >= chunk || d <= -chunk; d = target.Sub(g.origin) {
+		if d > 0 {
+			g.origin = g.origin.Add(chunk)
+		} else {
+			g.origin = g.origin.Add(-chunk)
+		}
+	}
+	d := target.Sub(g.origin)
+	k := d / g.step
+	if d%g.step < 0 {
+		k--
+	}
+	g.origin = g.origin.Add(k * g.step)
+	return g
+}
+
+// boundary returns the k-th boundary of the grid, where the origin is the 0th boundary.
+func (g grid) boundary(k int64) time.Time {
+	if g.loc == nil {
+		return g.origin.Add(time.Duration(k) * g.step)
+	}
+	return fromWallClock(g.origin.Add(time.Duration(k)*g.step), g.loc)
+}
+
+// index returns the index of the last boundary at or before t. t must be near the origin of the grid, as returned by
+// near.
+func (g grid) index(t time.Time) int64 {
+	var d time.Duration
+	if g.loc == nil {
+		d = t.Sub(g.origin)
+	} else {
+		d = wallClock(t, g.loc).Sub(g.origin)
+	}
+	k := int64(d / g.step)
+	if d%g.step < 0 {
+		k--
+	}
+	// on the wall clock, boundaries around daylight saving time transitions are not evenly spaced in absolute time
+	for g.boundary(k).After(t) {
+		k--
+	}
+	for !g.boundary(k + 1).After(t) {
+		k++
+	}
+	return k
+}
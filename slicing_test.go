@@ -0,0 +1,213 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectSlices returns at most limit slices from the iterator.
+func collectSlices(it *SliceIterator, limit int) []Period {
+	slices := make([]Period, 0)
+	for len(slices) < limit {
+		slice, ok := it.Next()
+		if !ok {
+			break
+		}
+		slices = append(slices, slice)
+	}
+	return slices
+}
+
+func TestPeriod_Slices(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	anchor := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		anchor   time.Time
+		name     string
+		p        Period
+		expected []Period
+		opts     SliceOptions
+		step     time.Duration
+	}{
+		{
+			name:   "aligned period is divided into full slices",
+			p:      NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+			step:   15 * time.Minute,
+			anchor: anchor,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 15, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 9, 15, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 30, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 9, 30, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 45, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 9, 45, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "partial slices are kept by default",
+			p:      NewPeriod(time.Date(2019, 1, 1, 9, 10, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 20, 0, 0, time.UTC)),
+			step:   time.Hour,
+			anchor: anchor,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 9, 10, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 20, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "partial slices are dropped",
+			p:      NewPeriod(time.Date(2019, 1, 1, 9, 10, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 20, 0, 0, time.UTC)),
+			step:   time.Hour,
+			anchor: anchor,
+			opts:   SliceOptions{First: DropPartial, Last: DropPartial},
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "partial slices are rounded up",
+			p:      NewPeriod(time.Date(2019, 1, 1, 9, 10, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 20, 0, 0, time.UTC)),
+			step:   time.Hour,
+			anchor: anchor,
+			opts:   SliceOptions{First: RoundUpPartial, Last: RoundUpPartial},
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "period within a single slice is rounded up on both ends",
+			p:      NewPeriod(time.Date(2019, 1, 1, 9, 10, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 20, 0, 0, time.UTC)),
+			step:   time.Hour,
+			anchor: anchor,
+			opts:   SliceOptions{First: RoundUpPartial, Last: RoundUpPartial},
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "slices are aligned to an anchor in the past or future",
+			p:      NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+			step:   time.Hour,
+			anchor: time.Date(2019, 6, 1, 0, 30, 0, 0, time.UTC),
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 30, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 9, 30, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "slices aligned to the local wall clock stay on the hour across a DST transition",
+			p:      NewPeriod(time.Date(2019, 3, 10, 0, 30, 0, 0, chiTz), time.Date(2019, 3, 10, 4, 0, 0, 0, chiTz)),
+			step:   time.Hour,
+			anchor: time.Date(2019, 1, 1, 0, 0, 0, 0, chiTz),
+			opts:   SliceOptions{Location: chiTz},
+			expected: []Period{
+				NewPeriod(time.Date(2019, 3, 10, 0, 30, 0, 0, chiTz), time.Date(2019, 3, 10, 1, 0, 0, 0, chiTz)),
+				NewPeriod(time.Date(2019, 3, 10, 1, 0, 0, 0, chiTz), time.Date(2019, 3, 10, 3, 0, 0, 0, chiTz)),
+				NewPeriod(time.Date(2019, 3, 10, 3, 0, 0, 0, chiTz), time.Date(2019, 3, 10, 4, 0, 0, 0, chiTz)),
+			},
+		}, {
+			name:   "slices aligned to the local wall clock are two hours long when the clock falls back",
+			p:      NewPeriod(time.Date(2019, 11, 3, 0, 0, 0, 0, chiTz), time.Date(2019, 11, 3, 3, 0, 0, 0, chiTz)),
+			step:   time.Hour,
+			anchor: time.Date(2019, 1, 1, 0, 0, 0, 0, chiTz),
+			opts:   SliceOptions{Location: chiTz},
+			expected: []Period{
+				NewPeriod(time.Date(2019, 11, 3, 0, 0, 0, 0, chiTz), time.Date(2019, 11, 3, 1, 0, 0, 0, chiTz)),
+				NewPeriod(time.Date(2019, 11, 3, 1, 0, 0, 0, chiTz), time.Date(2019, 11, 3, 2, 0, 0, 0, chiTz)),
+				NewPeriod(time.Date(2019, 11, 3, 2, 0, 0, 0, chiTz), time.Date(2019, 11, 3, 3, 0, 0, 0, chiTz)),
+			},
+		}, {
+			name:   "period unbounded on the end produces slices lazily",
+			p:      NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}),
+			step:   time.Hour,
+			anchor: anchor,
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "period unbounded on the start begins with a partial slice to the anchor",
+			p:      NewPeriod(time.Time{}, time.Date(2019, 1, 1, 1, 30, 0, 0, time.UTC)),
+			step:   time.Hour,
+			anchor: anchor,
+			expected: []Period{
+				NewPeriod(time.Time{}, time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 1, 0, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 1, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 1, 30, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "period unbounded on the start ending before the anchor is a single slice",
+			p:      NewPeriod(time.Time{}, time.Date(2018, 12, 31, 0, 0, 0, 0, time.UTC)),
+			step:   time.Hour,
+			anchor: anchor,
+			expected: []Period{
+				NewPeriod(time.Time{}, time.Date(2018, 12, 31, 0, 0, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "zero anchor aligns slices to the zero time",
+			p:      NewPeriod(time.Date(2019, 1, 1, 9, 10, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 40, 0, 0, time.UTC)),
+			step:   15 * time.Minute,
+			anchor: time.Time{},
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 9, 10, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 15, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 9, 15, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 30, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 9, 30, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 40, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "anchor centuries from the period",
+			p:      NewPeriod(time.Date(2019, 1, 1, 9, 10, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 40, 0, 0, time.UTC)),
+			step:   20 * time.Minute,
+			anchor: time.Date(2519, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []Period{
+				NewPeriod(time.Date(2019, 1, 1, 9, 10, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 20, 0, 0, time.UTC)),
+				NewPeriod(time.Date(2019, 1, 1, 9, 20, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 40, 0, 0, time.UTC)),
+			},
+		}, {
+			name:   "distant anchor on the wall clock",
+			p:      NewPeriod(time.Date(2019, 3, 10, 0, 30, 0, 0, chiTz), time.Date(2019, 3, 10, 4, 0, 0, 0, chiTz)),
+			step:   time.Hour,
+			anchor: time.Date(1500, 1, 1, 0, 0, 0, 0, chiTz),
+			opts:   SliceOptions{Location: chiTz, First: DropPartial},
+			expected: []Period{
+				NewPeriod(time.Date(2019, 3, 10, 1, 0, 0, 0, chiTz), time.Date(2019, 3, 10, 3, 0, 0, 0, chiTz)),
+				NewPeriod(time.Date(2019, 3, 10, 3, 0, 0, 0, chiTz), time.Date(2019, 3, 10, 4, 0, 0, 0, chiTz)),
+			},
+		}, {
+			name:     "zero anchor for a period unbounded on the start produces no slices",
+			p:        NewPeriod(time.Time{}, time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC)),
+			step:     time.Hour,
+			expected: []Period{},
+		}, {
+			name:     "non-positive step produces no slices",
+			p:        NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}),
+			anchor:   anchor,
+			expected: []Period{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// take one more slice than expected to check that the iterator stops, unless it never stops
+			limit := len(test.expected) + 1
+			if test.p.End.IsZero() {
+				limit = len(test.expected)
+			}
+			result := collectSlices(test.p.Slices(test.step, test.anchor, test.opts), limit)
+			require.Len(t, result, len(test.expected))
+			for i := range result {
+				assert.True(t, test.expected[i].Equals(result[i]), "expected %v, got %v", test.expected[i], result[i])
+			}
+		})
+	}
+}
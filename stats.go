@@ -0,0 +1,133 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// PeriodStats summarizes a set of periods within a window, such as the reservations of a parking space over a day.
+// Each period is clipped to the window before it is measured, and periods that do not intersect the window are
+// ignored. A period that is still unbounded after clipping has the maximum representable duration, as returned by
+// Period.Duration, and any sum that includes it is also the maximum representable duration.
+type PeriodStats struct {
+	// Window is the period within which the periods were measured. The zero Window is unbounded.
+	Window Period
+	// durations are the durations of the periods in ascending order
+	durations []time.Duration
+	// Count is the number of periods that intersect the window
+	Count int
+	// Total is the sum of the durations of the periods, so time covered by more than one period is counted more than
+	// once. Total saturates at the maximum representable duration if any period is unbounded.
+	Total time.Duration
+	// Union is the amount of time covered by at least one period. Union saturates at the maximum representable
+	// duration if any period is unbounded.
+	Union time.Duration
+	// Mean is the mean duration of the periods. Mean saturates at the maximum representable duration if any period
+	// is unbounded, however short the other periods are.
+	Mean time.Duration
+	// Median is the median duration of the periods
+	Median time.Duration
+	// LongestGap is the longest time within the window that is not covered by any period. Time before the first
+	// period or after the last period is only counted if the window is bounded on that side.
+	LongestGap time.Duration
+	// Utilization is the fraction of the window covered by at least one period, or 0 if the window is unbounded or
+	// empty. Because a period can only be unbounded after clipping when the window is unbounded, Utilization is 0
+	// whenever Union has saturated.
+	Utilization float64
+}
+
+// NewPeriodStats returns the PeriodStats of the periods within the window. Use a zero window to measure the periods
+// without clipping them.
+func NewPeriodStats(periods []Period, window Period) PeriodStats {
+	stats := PeriodStats{Window: window}
+	clipped := make([]Period, 0, len(periods))
+	for _, p := range periods {
+		if c, ok := p.Intersection(window); ok {
+			clipped = append(clipped, c)
+			stats.durations = append(stats.durations, c.Duration())
+			stats.Total = addDurations(stats.Total, c.Duration())
+		}
+	}
+	stats.Count = len(clipped)
+	sort.Slice(stats.durations, func(i, j int) bool {
+		return stats.durations[i] < stats.durations[j]
+	})
+	if stats.Count > 0 {
+		stats.Mean = stats.Total / time.Duration(stats.Count)
+		if stats.Total == maxDuration {
+			stats.Mean = maxDuration
+		}
+		stats.Median = stats.Percentile(50)
+	}
+
+	// the gaps are the time between the merged periods, and between the ends of the window and the merged periods
+	previousEnd := window.Start
+	for _, m := range MergePeriods(clipped) {
+		stats.Union = addDurations(stats.Union, m.Duration())
+		if !previousEnd.IsZero() && !m.Start.IsZero() {
+			stats.LongestGap = max(stats.LongestGap, m.Start.Sub(previousEnd))
+		}
+		previousEnd = m.End
+	}
+	if !previousEnd.IsZero() && !window.End.IsZero() {
+		stats.LongestGap = max(stats.LongestGap, window.End.Sub(previousEnd))
+	}
+	if window.Duration() != maxDuration && window.Duration() > 0 {
+		stats.Utilization = float64(stats.Union) / float64(window.Duration())
+	}
+	return stats
+}
+
+// Percentile returns the duration below which the given percentage of period durations fall, interpolating linearly
+// between the closest durations. Percentages are clamped between 0 and 100, so Percentile(0) is the shortest duration
+// and Percentile(100) is the longest. Interpolating towards an unbounded duration returns the maximum representable
+// duration. If there are no periods, 0 is returned.
+func (s PeriodStats) Percentile(percent float64) time.Duration {
+	if len(s.durations) == 0 {
+		return 0
+	}
+	rank := math.Min(math.Max(percent, 0), 100) / 100 * float64(len(s.durations)-1)
+	lower, upper := s.durations[int(math.Floor(rank))], s.durations[int(math.Ceil(rank))]
+	if upper == maxDuration && rank > math.Floor(rank) {
+		return maxDuration
+	}
+	return lower + time.Duration(float64(upper-lower)*(rank-math.Floor(rank)))
+}
+
+// Stats returns the PeriodStats of the periods in the collection that intersect the window. Use a zero window to
+// measure every period in the collection.
+func (pc *PeriodCollection[K, V]) Stats(window Period) PeriodStats {
+	pc.intervals.mutex.RLock()
+	defer pc.intervals.mutex.RUnlock()
+	var periods []Period
+	if !pc.intervals.root.leaf {
+		pc.intervals.intersecting(window.Interval(), pc.intervals.root, func(n *node[time.Time, K, V]) {
+			periods = append(periods, NewPeriodFromInterval(n.interval))
+		})
+	}
+	return NewPeriodStats(periods, window)
+}
+
+// addDurations returns the sum of two non-negative durations, or the maximum representable duration if the sum
+// overflows.
+func addDurations(a, b time.Duration) time.Duration {
+	if a > maxDuration-b {
+		return maxDuration
+	}
+	return a + b
+}
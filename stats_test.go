@@ -0,0 +1,143 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPeriodStats(t *testing.T) {
+	window := NewPeriod(time.Unix(0, 0), time.Unix(100, 0))
+	tests := []struct {
+		name     string
+		periods  []Period
+		window   Period
+		expected PeriodStats
+	}{
+		{
+			name: "periods within the window",
+			periods: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+				NewPeriod(time.Unix(15, 0), time.Unix(45, 0)),
+				NewPeriod(time.Unix(60, 0), time.Unix(80, 0)),
+			},
+			window: window,
+			expected: PeriodStats{
+				Window:      window,
+				Count:       3,
+				Total:       60 * time.Second,
+				Union:       55 * time.Second,
+				Mean:        20 * time.Second,
+				Median:      20 * time.Second,
+				LongestGap:  20 * time.Second,
+				Utilization: 0.55,
+				durations:   []time.Duration{10 * time.Second, 20 * time.Second, 30 * time.Second},
+			},
+		}, {
+			name: "periods are clipped to the window",
+			periods: []Period{
+				NewPeriod(time.Time{}, time.Unix(10, 0)),
+				NewPeriod(time.Unix(90, 0), time.Unix(200, 0)),
+				NewPeriod(time.Unix(100, 0), time.Unix(200, 0)),
+			},
+			window: window,
+			expected: PeriodStats{
+				Window:      window,
+				Count:       2,
+				Total:       20 * time.Second,
+				Union:       20 * time.Second,
+				Mean:        10 * time.Second,
+				Median:      10 * time.Second,
+				LongestGap:  80 * time.Second,
+				Utilization: 0.2,
+				durations:   []time.Duration{10 * time.Second, 10 * time.Second},
+			},
+		}, {
+			name:   "no periods leaves the whole window as a gap",
+			window: window,
+			expected: PeriodStats{
+				Window:     window,
+				LongestGap: 100 * time.Second,
+			},
+		}, {
+			name: "unbounded window only counts gaps between periods",
+			periods: []Period{
+				NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+				NewPeriod(time.Unix(25, 0), time.Time{}),
+			},
+			expected: PeriodStats{
+				Count:      2,
+				Total:      maxDuration,
+				Union:      maxDuration,
+				Mean:       maxDuration,
+				Median:     maxDuration,
+				LongestGap: 5 * time.Second,
+				durations:  []time.Duration{10 * time.Second, maxDuration},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stats := NewPeriodStats(test.periods, test.window)
+			assert.InDelta(t, test.expected.Utilization, stats.Utilization, 1e-9)
+			stats.Utilization = test.expected.Utilization
+			assert.Equal(t, test.expected, stats)
+		})
+	}
+}
+
+func TestPeriodStats_Percentile(t *testing.T) {
+	periods := []Period{
+		NewPeriod(time.Unix(0, 0), time.Unix(10, 0)),
+		NewPeriod(time.Unix(0, 0), time.Unix(40, 0)),
+		NewPeriod(time.Unix(0, 0), time.Unix(20, 0)),
+		NewPeriod(time.Unix(0, 0), time.Unix(30, 0)),
+	}
+	stats := NewPeriodStats(periods, Period{})
+	tests := []struct {
+		percent  float64
+		expected time.Duration
+	}{
+		{0, 10 * time.Second},
+		{50, 25 * time.Second},
+		{90, 37 * time.Second},
+		{100, 40 * time.Second},
+		{150, 40 * time.Second},
+		{-10, 10 * time.Second},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, stats.Percentile(test.percent), "percentile %v", test.percent)
+	}
+	assert.Equal(t, time.Duration(0), PeriodStats{}.Percentile(50))
+}
+
+func TestPeriodCollection_Stats(t *testing.T) {
+	pc := NewPeriodCollection[int, int]()
+	require.NoError(t, pc.Insert(0, NewPeriod(time.Unix(10, 0), time.Unix(20, 0)), 0))
+	require.NoError(t, pc.Insert(1, NewPeriod(time.Unix(40, 0), time.Unix(60, 0)), 1))
+	require.NoError(t, pc.Insert(2, NewPeriod(time.Unix(200, 0), time.Unix(300, 0)), 2))
+	window := NewPeriod(time.Unix(0, 0), time.Unix(50, 0))
+	assert.Equal(t, NewPeriodStats([]Period{
+		NewPeriod(time.Unix(10, 0), time.Unix(20, 0)),
+		NewPeriod(time.Unix(40, 0), time.Unix(60, 0)),
+	}, window), pc.Stats(window))
+	assert.Equal(t, 3, pc.Stats(Period{}).Count)
+	assert.Equal(t, PeriodStats{Window: window, LongestGap: 50 * time.Second},
+		NewPeriodCollection[int, int]().Stats(window))
+}
@@ -0,0 +1,225 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dayLength is the length of a day on the wall clock
+const dayLength = HoursInDay * time.Hour
+
+// TimeOfDay is a time on the 24-hour wall clock, stored as the duration since midnight. Valid times of day are from
+// midnight (00:00) up to and including the end of the day (24:00), which is useful as the end time of a period that
+// lasts until midnight.
+type TimeOfDay time.Duration
+
+// Midnight is the beginning of the day, 00:00
+const Midnight TimeOfDay = 0
+
+// EndOfDay is the end of the day, 24:00
+const EndOfDay = TimeOfDay(dayLength)
+
+// NewTimeOfDay constructs a new TimeOfDay from an hour from 0 to 24, a minute and a second. An error is returned if
+// any of the values are out of range, or if the hour is 24 and the minute or second is not 0.
+func NewTimeOfDay(hour, minute, second int) (TimeOfDay, error) {
+	if hour < 0 || hour > HoursInDay || minute < 0 || minute > 59 || second < 0 || second > 59 {
+		return 0, fmt.Errorf("invalid time of day %02d:%02d:%02d", hour, minute, second)
+	}
+	tod := TimeOfDay(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second)
+	if !tod.Valid() {
+		return 0, fmt.Errorf("invalid time of day %02d:%02d:%02d", hour, minute, second)
+	}
+	return tod, nil
+}
+
+// TimeOfDayOf returns the time of day on the local wall clock of t in its location.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	hour, minute, second := t.Clock()
+	return TimeOfDay(
+		time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second +
+			time.Duration(t.Nanosecond()))
+}
+
+// ParseTimeOfDay parses a time of day on either the 24-hour clock, such as "17:30", "17:30:15.5" or "24:00", or the
+// 12-hour clock, such as "5:30 PM", "5:30pm" or "5 PM".
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	clock := strings.TrimSpace(s)
+	twelveHour, pm := false, false
+	upper := strings.ToUpper(clock)
+	if strings.HasSuffix(upper, "AM") || strings.HasSuffix(upper, "PM") {
+		twelveHour, pm = true, strings.HasSuffix(upper, "PM")
+		clock = strings.TrimSpace(clock[:len(clock)-2])
+	}
+	parts := strings.Split(clock, ":")
+	if len(parts) > 3 || (!twelveHour && len(parts) < 2) {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	var fields [3]int
+	var nanos int
+	for i, part := range parts {
+		if i == 2 {
+			// seconds may have a fractional part
+			var fraction string
+			part, fraction, _ = strings.Cut(part, ".")
+			if len(fraction) > 9 || strings.Trim(fraction, "0123456789") != "" {
+				return 0, fmt.Errorf("invalid time of day %q", s)
+			}
+			nanos, _ = strconv.Atoi((fraction + "000000000")[:9])
+		}
+		if part == "" || strings.Trim(part, "0123456789") != "" || (i > 0 && len(part) != 2) {
+			return 0, fmt.Errorf("invalid time of day %q", s)
+		}
+		fields[i], _ = strconv.Atoi(part)
+	}
+	if twelveHour {
+		if fields[0] < 1 || fields[0] > 12 {
+			return 0, fmt.Errorf("invalid time of day %q: hour must be between 1 and 12", s)
+		}
+		fields[0] %= 12
+		if pm {
+			fields[0] += 12
+		}
+	}
+	tod, err := NewTimeOfDay(fields[0], fields[1], fields[2])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	tod += TimeOfDay(nanos)
+	if !tod.Valid() {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	return tod, nil
+}
+
+// Valid returns whether the TimeOfDay is between 00:00 and 24:00 inclusive.
+func (t TimeOfDay) Valid() bool {
+	return t >= Midnight && t <= EndOfDay
+}
+
+// Duration returns the time since midnight.
+func (t TimeOfDay) Duration() time.Duration {
+	return time.Duration(t)
+}
+
+// Hour returns the hour of the TimeOfDay, from 0 to 24.
+func (t TimeOfDay) Hour() int {
+	return int(time.Duration(t) / time.Hour)
+}
+
+// Minute returns the minute within the hour of the TimeOfDay, from 0 to 59.
+func (t TimeOfDay) Minute() int {
+	return int(time.Duration(t) % time.Hour / time.Minute)
+}
+
+// Second returns the second within the minute of the TimeOfDay, from 0 to 59.
+func (t TimeOfDay) Second() int {
+	return int(time.Duration(t) % time.Minute / time.Second)
+}
+
+// Nanosecond returns the nanosecond within the second of the TimeOfDay.
+func (t TimeOfDay) Nanosecond() int {
+	return int(time.Duration(t) % time.Second)
+}
+
+// Compare returns -1 if the TimeOfDay is before other, 1 if it is after other and 0 if they are the same.
+func (t TimeOfDay) Compare(other TimeOfDay) int {
+	return cmp.Compare(t, other)
+}
+
+// Before returns whether the TimeOfDay is before the other time of day.
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t < other
+}
+
+// After returns whether the TimeOfDay is after the other time of day.
+func (t TimeOfDay) After(other TimeOfDay) bool {
+	return t > other
+}
+
+// Add returns the time of day d after the TimeOfDay, wrapping around midnight, so that 23:00 plus 2 hours is 01:00.
+// The result is always before 24:00.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	result := (time.Duration(t) + d%dayLength) % dayLength
+	if result < 0 {
+		result += dayLength
+	}
+	return TimeOfDay(result)
+}
+
+// Sub returns the duration from the other time of day to the TimeOfDay, which is negative if other is later in the
+// day.
+func (t TimeOfDay) Sub(other TimeOfDay) time.Duration {
+	return time.Duration(t - other)
+}
+
+// On returns the time at which the wall clock in the given location shows the TimeOfDay on the given date. A time of
+// day that is skipped by a daylight saving time transition resolves to the end of the transition, and one that is
+// repeated resolves to its first occurrence. If loc is nil, UTC is used.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return fromWallClock(d.midnight().Add(time.Duration(t)), loc)
+}
+
+// String returns the TimeOfDay on the 24-hour clock, eg "17:30", including seconds and fractional seconds only if
+// they are not zero, eg "17:30:15.5".
+func (t TimeOfDay) String() string {
+	if time.Duration(t)%time.Minute != 0 {
+		return t.format(true)
+	}
+	return t.format(false)
+}
+
+// TwelveHourString returns the TimeOfDay on the 12-hour clock in the same format as TwelveHourDisplay, eg "5:30 PM".
+func (t TimeOfDay) TwelveHourString() string {
+	return TwelveHourDisplay(time.Duration(t) % dayLength)
+}
+
+// MarshalText implements encoding.TextMarshaler, formatting the TimeOfDay as returned by String.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	if !t.Valid() {
+		return nil, fmt.Errorf("invalid time of day %v", time.Duration(t))
+	}
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting any time of day that can be parsed by
+// ParseTimeOfDay.
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	parsed, err := ParseTimeOfDay(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// format returns the TimeOfDay on the 24-hour clock. The short form has hours and minutes, eg "09:00", and the long
+// form adds seconds and any fractional seconds, eg "09:00:00".
+func (t TimeOfDay) format(long bool) string {
+	if !long {
+		return fmt.Sprintf("%02d:%02d", t.Hour(), t.Minute())
+	}
+	if t.Nanosecond() == 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second())
+	}
+	return strings.TrimRight(fmt.Sprintf("%02d:%02d:%02d.%09d", t.Hour(), t.Minute(), t.Second(), t.Nanosecond()), "0")
+}
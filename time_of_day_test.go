@@ -0,0 +1,209 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimeOfDay(t *testing.T) {
+	tests := []struct {
+		name        string
+		hour        int
+		minute      int
+		second      int
+		expected    TimeOfDay
+		expectError bool
+	}{
+		{
+			name:     "time of day",
+			hour:     17,
+			minute:   30,
+			second:   15,
+			expected: TimeOfDay(17*time.Hour + 30*time.Minute + 15*time.Second),
+		}, {
+			name:     "end of day",
+			hour:     24,
+			expected: EndOfDay,
+		}, {
+			name:        "after the end of day",
+			hour:        24,
+			minute:      1,
+			expectError: true,
+		}, {
+			name:        "negative hour",
+			hour:        -1,
+			expectError: true,
+		}, {
+			name:        "minute out of range",
+			hour:        1,
+			minute:      60,
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := NewTimeOfDay(test.hour, test.minute, test.second)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    TimeOfDay
+		expectError bool
+	}{
+		{name: "24-hour clock", input: "17:30", expected: TimeOfDay(17*time.Hour + 30*time.Minute)},
+		{
+			name:     "24-hour clock with fractional seconds",
+			input:    "17:30:15.5",
+			expected: TimeOfDay(17*time.Hour + 30*time.Minute + 15500*time.Millisecond),
+		},
+		{name: "end of day", input: "24:00", expected: EndOfDay},
+		{name: "12-hour clock", input: "5:30 PM", expected: TimeOfDay(17*time.Hour + 30*time.Minute)},
+		{name: "12-hour clock without minutes", input: "5pm", expected: TimeOfDay(17 * time.Hour)},
+		{name: "midnight on the 12-hour clock", input: "12:00 AM", expected: Midnight},
+		{name: "noon on the 12-hour clock", input: "12:00 pm", expected: TimeOfDay(12 * time.Hour)},
+		{name: "hour without minutes", input: "17", expectError: true},
+		{name: "after the end of day", input: "24:00:01", expectError: true},
+		{name: "hour out of range on the 12-hour clock", input: "13:00 PM", expectError: true},
+		{name: "single digit minutes", input: "5:3", expectError: true},
+		{name: "not a time", input: "noon", expectError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ParseTimeOfDay(test.input)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestTimeOfDay_String(t *testing.T) {
+	tests := []struct {
+		name             string
+		expected         string
+		expectedTwelveHr string
+		tod              TimeOfDay
+	}{
+		{name: "minutes", tod: TimeOfDay(9 * time.Hour), expected: "09:00", expectedTwelveHr: "9:00 AM"},
+		{
+			name:             "seconds",
+			tod:              TimeOfDay(17*time.Hour + 30*time.Minute + 15*time.Second),
+			expected:         "17:30:15",
+			expectedTwelveHr: "5:30 PM",
+		}, {
+			name:             "fractional seconds",
+			tod:              TimeOfDay(15500 * time.Millisecond),
+			expected:         "00:00:15.5",
+			expectedTwelveHr: "12:00 AM",
+		},
+		{name: "end of day", tod: EndOfDay, expected: "24:00", expectedTwelveHr: "12:00 AM"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.tod.String())
+			assert.Equal(t, test.expectedTwelveHr, test.tod.TwelveHourString())
+			parsed, err := ParseTimeOfDay(test.tod.String())
+			require.NoError(t, err)
+			assert.Equal(t, test.tod, parsed)
+		})
+	}
+}
+
+func TestTimeOfDay_Arithmetic(t *testing.T) {
+	tod := TimeOfDay(23 * time.Hour)
+	assert.Equal(t, TimeOfDay(time.Hour), tod.Add(2*time.Hour))
+	assert.Equal(t, TimeOfDay(22*time.Hour), tod.Add(-25*time.Hour))
+	assert.Equal(t, Midnight, EndOfDay.Add(0))
+	assert.Equal(t, 22*time.Hour, tod.Sub(TimeOfDay(time.Hour)))
+	assert.Equal(t, -22*time.Hour, TimeOfDay(time.Hour).Sub(tod))
+	assert.True(t, TimeOfDay(time.Hour).Before(tod))
+	assert.True(t, tod.After(TimeOfDay(time.Hour)))
+	assert.Equal(t, 0, tod.Compare(TimeOfDay(23*time.Hour)))
+	assert.Equal(t, 23, tod.Hour())
+	assert.False(t, TimeOfDay(25*time.Hour).Valid())
+	assert.False(t, TimeOfDay(-time.Hour).Valid())
+}
+
+func TestTimeOfDay_On(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	tod := TimeOfDay(2*time.Hour + 30*time.Minute)
+	assert.True(t, time.Date(2019, 3, 9, 2, 30, 0, 0, chiTz).Equal(tod.On(NewDate(2019, 3, 9), chiTz)))
+	// 02:30 is skipped when daylight saving time begins, so the end of the transition is used
+	assert.True(t, time.Date(2019, 3, 10, 3, 0, 0, 0, chiTz).Equal(tod.On(NewDate(2019, 3, 10), chiTz)))
+	assert.True(t, time.Date(2019, 3, 11, 0, 0, 0, 0, time.UTC).Equal(EndOfDay.On(NewDate(2019, 3, 10), nil)))
+	assert.Equal(t, tod, TimeOfDayOf(tod.On(NewDate(2019, 3, 9), chiTz)))
+}
+
+func TestTimeOfDay_JSON(t *testing.T) {
+	type hours struct {
+		Open  TimeOfDay `json:"open"`
+		Close TimeOfDay `json:"close"`
+	}
+	marshaled, err := json.Marshal(hours{Open: TimeOfDay(9 * time.Hour), Close: EndOfDay})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"open": "09:00", "close": "24:00"}`, string(marshaled))
+	var unmarshaled hours
+	require.NoError(t, json.Unmarshal([]byte(`{"open": "9:30 AM", "close": "17:00"}`), &unmarshaled))
+	assert.Equal(t, hours{Open: TimeOfDay(9*time.Hour + 30*time.Minute), Close: TimeOfDay(17 * time.Hour)}, unmarshaled)
+	assert.Error(t, json.Unmarshal([]byte(`{"open": "25:00"}`), &unmarshaled))
+	_, err = json.Marshal(hours{Open: TimeOfDay(-time.Hour)})
+	assert.Error(t, err)
+}
+
+func TestNewContinuousPeriodFromTimeOfDay(t *testing.T) {
+	cp, err := NewContinuousPeriodFromTimeOfDay(
+		TimeOfDay(9*time.Hour), EndOfDay, time.Monday, time.Friday, nil)
+	require.NoError(t, err)
+	assert.Equal(t, NewContinuousPeriod(9*time.Hour, 24*time.Hour, time.Monday, time.Friday, nil), cp)
+	assert.Equal(t, TimeOfDay(9*time.Hour), cp.StartTimeOfDay())
+	assert.Equal(t, EndOfDay, cp.EndTimeOfDay())
+	_, err = NewContinuousPeriodFromTimeOfDay(TimeOfDay(30*time.Hour), EndOfDay, time.Monday, time.Friday, nil)
+	assert.IsType(t, ContinuousPeriodConstructionError(""), err)
+}
+
+func TestNewFloatingPeriodFromTimeOfDay(t *testing.T) {
+	days := ApplicableDays{Monday: true}
+	fp, err := NewFloatingPeriodFromTimeOfDay(TimeOfDay(9*time.Hour), TimeOfDay(17*time.Hour), days, nil, false)
+	require.NoError(t, err)
+	expected, err := NewFloatingPeriod(9*time.Hour, 17*time.Hour, days, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, expected, fp)
+	assert.Equal(t, TimeOfDay(9*time.Hour), fp.StartTimeOfDay())
+	assert.Equal(t, TimeOfDay(17*time.Hour), fp.EndTimeOfDay())
+	_, err = NewFloatingPeriodFromTimeOfDay(TimeOfDay(-time.Hour), TimeOfDay(17*time.Hour), days, nil, false)
+	assert.IsType(t, FloatingPeriodConstructionError(""), err)
+	_, err = NewFloatingPeriodFromTimeOfDay(TimeOfDay(9*time.Hour), TimeOfDay(17*time.Hour), ApplicableDays{}, nil, false)
+	assert.IsType(t, FloatingPeriodConstructionError(""), err)
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"time"
+)
+
+// Shift returns a new Period with the start and end moved later by d, or earlier if d is negative. Unbounded ends
+// remain unbounded.
+func (p Period) Shift(d time.Duration) Period {
+	return p.ExtendStart(-d).ExtendEnd(d)
+}
+
+// ExtendStart returns a new Period that starts earlier by d, or later if d is negative. An unbounded start remains
+// unbounded.
+func (p Period) ExtendStart(d time.Duration) Period {
+	if !p.Start.IsZero() {
+		p.Start = p.Start.Add(-d)
+	}
+	return p
+}
+
+// ExtendEnd returns a new Period that ends later by d, or earlier if d is negative. An unbounded end remains
+// unbounded.
+func (p Period) ExtendEnd(d time.Duration) Period {
+	if !p.End.IsZero() {
+		p.End = p.End.Add(d)
+	}
+	return p
+}
+
+// Clamp returns a new Period with its start and end moved within the given bounds. A start before the start of
+// bounds, including an unbounded start, is moved to the start of bounds along with its inclusivity, and likewise for
+// an end after the end of bounds. A Period that lies entirely outside of bounds is clamped to an empty period at the
+// nearest end of bounds.
+func (p Period) Clamp(bounds Period) Period {
+	if !p.Start.IsZero() && !bounds.End.IsZero() && !startsBeforeEnd(p, bounds) {
+		return NewPeriod(bounds.End, bounds.End)
+	}
+	if !p.End.IsZero() && !bounds.Start.IsZero() && !startsBeforeEnd(bounds, p) {
+		return NewPeriod(bounds.Start, bounds.Start)
+	}
+	if compareStarts(p, bounds) < 0 {
+		p.Start = bounds.Start
+		p.Bounds = boundsOf(bounds.Bounds.StartInclusive(), p.Bounds.EndInclusive())
+	}
+	if compareEnds(p, bounds) > 0 {
+		p.End = bounds.End
+		p.Bounds = boundsOf(p.Bounds.StartInclusive(), bounds.Bounds.EndInclusive())
+	}
+	return p
+}
+
+// Scale returns a new Period with the same start whose duration is the duration of the Period multiplied by factor.
+// A factor that is not positive returns an empty period at the start of the Period. A Period that is unbounded on
+// either end is returned unchanged.
+func (p Period) Scale(factor float64) Period {
+	if p.Start.IsZero() || p.End.IsZero() {
+		return p
+	}
+	if factor <= 0 {
+		return NewPeriod(p.Start, p.Start)
+	}
+	p.End = p.Start.Add(time.Duration(float64(p.End.Sub(p.Start)) * factor))
+	return p
+}
+
+// WallClockShift returns a new Period with the start and end moved by the given number of calendar days in the
+// given location, keeping the same local wall clock times. Unlike Shift, this accounts for daylight saving time
+// transitions, so shifting a period from 9:00 to 17:00 by one day always results in a period from 9:00 to 17:00 on
+// the following day. A local time that does not exist on the new day resolves to the end of the transition. If loc
+// is nil, the start and end are shifted in their own locations. Unbounded ends remain unbounded.
+func (p Period) WallClockShift(days int, loc *time.Location) Period {
+	shift := func(t time.Time) time.Time {
+		if t.IsZero() {
+			return t
+		}
+		l := loc
+		if l == nil {
+			l = t.Location()
+		}
+		return fromWallClock(wallClock(t, l).AddDate(0, 0, days), l)
+	}
+	p.Start, p.End = shift(p.Start), shift(p.End)
+	return p
+}
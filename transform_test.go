@@ -0,0 +1,194 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriod_Shift(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        Period
+		expected Period
+		d        time.Duration
+	}{
+		{
+			name:     "period is shifted later",
+			p:        NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+			d:        time.Hour,
+			expected: NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "period is shifted earlier",
+			p:        NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+			d:        -time.Hour,
+			expected: NewPeriod(time.Date(2019, 1, 1, 8, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "unbounded end remains unbounded",
+			p:        NewBoundedPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}, OpenOpen),
+			d:        time.Hour,
+			expected: NewBoundedPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Time{}, OpenOpen),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.p.Shift(test.d)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
+
+func TestPeriod_ExtendStart(t *testing.T) {
+	p := NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC))
+	assert.Equal(
+		t, NewPeriod(time.Date(2019, 1, 1, 8, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		p.ExtendStart(time.Hour))
+	assert.Equal(
+		t, NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		p.ExtendStart(-time.Hour))
+	unbounded := NewPeriod(time.Time{}, time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC))
+	assert.Equal(t, unbounded, unbounded.ExtendStart(time.Hour))
+}
+
+func TestPeriod_ExtendEnd(t *testing.T) {
+	p := NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC))
+	assert.Equal(
+		t, NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)),
+		p.ExtendEnd(time.Hour))
+	assert.Equal(
+		t, NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)),
+		p.ExtendEnd(-time.Hour))
+	unbounded := NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{})
+	assert.Equal(t, unbounded, unbounded.ExtendEnd(time.Hour))
+}
+
+func TestPeriod_Clamp(t *testing.T) {
+	bounds := NewBoundedPeriod(
+		time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 17, 0, 0, 0, time.UTC), ClosedClosed)
+	tests := []struct {
+		name     string
+		p        Period
+		bounds   Period
+		expected Period
+	}{
+		{
+			name:     "period within bounds is unchanged",
+			p:        NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+			bounds:   bounds,
+			expected: NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:   "start and end are clamped with the inclusivity of bounds",
+			p:      NewPeriod(time.Date(2019, 1, 1, 8, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 18, 0, 0, 0, time.UTC)),
+			bounds: bounds,
+			expected: NewBoundedPeriod(
+				time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 17, 0, 0, 0, time.UTC), ClosedClosed),
+		}, {
+			name:   "unbounded start is clamped",
+			p:      NewPeriod(time.Time{}, time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+			bounds: bounds,
+			expected: NewPeriod(
+				time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "unbounded end of bounds does not clamp",
+			p:        NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Time{}),
+			bounds:   NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{}),
+			expected: NewPeriod(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC), time.Time{}),
+		}, {
+			name:     "period before bounds is empty at the start of bounds",
+			p:        NewPeriod(time.Date(2019, 1, 1, 6, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 7, 0, 0, 0, time.UTC)),
+			bounds:   bounds,
+			expected: NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC)),
+		}, {
+			name:     "period after bounds is empty at the end of bounds",
+			p:        NewPeriod(time.Date(2019, 1, 1, 18, 0, 0, 0, time.UTC), time.Time{}),
+			bounds:   bounds,
+			expected: NewPeriod(time.Date(2019, 1, 1, 17, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 17, 0, 0, 0, time.UTC)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.p.Clamp(test.bounds)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
+
+func TestPeriod_Scale(t *testing.T) {
+	p := NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 11, 0, 0, 0, time.UTC))
+	assert.Equal(
+		t, NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)),
+		p.Scale(1.5))
+	assert.Equal(
+		t, NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 30, 0, 0, time.UTC)),
+		p.Scale(0.25))
+	assert.Equal(
+		t, NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC)),
+		p.Scale(-1))
+	unbounded := NewPeriod(time.Date(2019, 1, 1, 9, 0, 0, 0, time.UTC), time.Time{})
+	assert.Equal(t, unbounded, unbounded.Scale(2))
+}
+
+func TestPeriod_WallClockShift(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	tests := []struct {
+		loc      *time.Location
+		name     string
+		p        Period
+		expected Period
+		days     int
+	}{
+		{
+			name:     "period is shifted across a DST transition keeping wall clock times",
+			p:        NewPeriod(time.Date(2019, 3, 9, 9, 0, 0, 0, chiTz), time.Date(2019, 3, 9, 17, 0, 0, 0, chiTz)),
+			days:     1,
+			loc:      chiTz,
+			expected: NewPeriod(time.Date(2019, 3, 10, 9, 0, 0, 0, chiTz), time.Date(2019, 3, 10, 17, 0, 0, 0, chiTz)),
+		}, {
+			name:     "period is shifted backwards",
+			p:        NewPeriod(time.Date(2019, 11, 4, 9, 0, 0, 0, chiTz), time.Date(2019, 11, 4, 17, 0, 0, 0, chiTz)),
+			days:     -1,
+			loc:      chiTz,
+			expected: NewPeriod(time.Date(2019, 11, 3, 9, 0, 0, 0, chiTz), time.Date(2019, 11, 3, 17, 0, 0, 0, chiTz)),
+		}, {
+			name:     "times are shifted in the given location",
+			p:        NewPeriod(time.Date(2019, 3, 9, 15, 0, 0, 0, time.UTC), time.Date(2019, 3, 9, 23, 0, 0, 0, time.UTC)),
+			days:     1,
+			loc:      chiTz,
+			expected: NewPeriod(time.Date(2019, 3, 10, 9, 0, 0, 0, chiTz), time.Date(2019, 3, 10, 17, 0, 0, 0, chiTz)),
+		}, {
+			name:     "nil location shifts times in their own location",
+			p:        NewPeriod(time.Date(2019, 3, 9, 9, 0, 0, 0, chiTz), time.Time{}),
+			days:     1,
+			expected: NewPeriod(time.Date(2019, 3, 10, 9, 0, 0, 0, chiTz), time.Time{}),
+		}, {
+			name:     "nonexistent local time resolves to the end of the transition",
+			p:        NewPeriod(time.Date(2019, 3, 9, 2, 30, 0, 0, chiTz), time.Date(2019, 3, 9, 5, 0, 0, 0, chiTz)),
+			days:     1,
+			loc:      chiTz,
+			expected: NewPeriod(time.Date(2019, 3, 10, 3, 0, 0, 0, chiTz), time.Date(2019, 3, 10, 5, 0, 0, 0, chiTz)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.p.WallClockShift(test.days, test.loc)
+			assert.True(t, test.expected.Equals(result), "expected %v, got %v", test.expected, result)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+)
+
+// weekLength is the length of a week on the wall clock
+const weekLength = DaysInWeek * dayLength
+
+// WeekTime is a position within a week on the wall clock, such as Monday at 09:00. A TimeOfDay of 24:00 is the same
+// position in the week as 00:00 on the following day.
+type WeekTime struct {
+	Weekday   time.Weekday
+	TimeOfDay TimeOfDay
+}
+
+// NewWeekTime constructs a new WeekTime.
+func NewWeekTime(weekday time.Weekday, tod TimeOfDay) WeekTime {
+	return WeekTime{Weekday: weekday, TimeOfDay: tod}
+}
+
+// WeekTimeOf returns the position within the week of t on the local wall clock of its location.
+func WeekTimeOf(t time.Time) WeekTime {
+	return WeekTime{Weekday: t.Weekday(), TimeOfDay: TimeOfDayOf(t)}
+}
+
+// Valid returns whether the WeekTime has a valid day of the week and time of day.
+func (w WeekTime) Valid() bool {
+	return w.Weekday >= time.Sunday && w.Weekday <= time.Saturday && w.TimeOfDay.Valid()
+}
+
+// Compare returns -1 if the WeekTime is earlier in the week than other, 1 if it is later in the week and 0 if they
+// are the same position in the week. Weeks begin on Sunday.
+func (w WeekTime) Compare(other WeekTime) int {
+	return cmp.Compare(w.sinceWeekStart(), other.sinceWeekStart())
+}
+
+// Before returns whether the WeekTime is earlier in the week than the other week time.
+func (w WeekTime) Before(other WeekTime) bool {
+	return w.Compare(other) < 0
+}
+
+// After returns whether the WeekTime is later in the week than the other week time.
+func (w WeekTime) After(other WeekTime) bool {
+	return w.Compare(other) > 0
+}
+
+// Add returns the WeekTime d after the WeekTime, wrapping around the end of the week, so that Saturday 23:00 plus 2
+// hours is Sunday 01:00. The time of day of the result is always before 24:00.
+func (w WeekTime) Add(d time.Duration) WeekTime {
+	offset := (w.sinceWeekStart() + d%weekLength) % weekLength
+	if offset < 0 {
+		offset += weekLength
+	}
+	return WeekTime{Weekday: time.Weekday(offset / dayLength), TimeOfDay: TimeOfDay(offset % dayLength)}
+}
+
+// Until returns the wall clock duration from the WeekTime forward to the next occurrence of the other week time,
+// which is always at least 0 and less than a week.
+func (w WeekTime) Until(other WeekTime) time.Duration {
+	d := (other.sinceWeekStart() - w.sinceWeekStart()) % weekLength
+	if d < 0 {
+		d += weekLength
+	}
+	return d
+}
+
+// Next returns the first time at or after t at which the wall clock in the given location shows the WeekTime. A time
+// that is skipped by a daylight saving time transition resolves to the end of the transition. If loc is nil, UTC is
+// used.
+func (w WeekTime) Next(t time.Time, loc *time.Location) time.Time {
+	for _, candidate := range w.candidates(t, loc) {
+		if !candidate.Before(t) {
+			return candidate
+		}
+	}
+	// unreachable, since the last candidate is at least a week after t
+	return time.Time{}
+}
+
+// Prev returns the last time at or before t at which the wall clock in the given location shows the WeekTime. A time
+// that is skipped by a daylight saving time transition resolves to the end of the transition. If loc is nil, UTC is
+// used.
+func (w WeekTime) Prev(t time.Time, loc *time.Location) time.Time {
+	candidates := w.candidates(t, loc)
+	for i := len(candidates) - 1; i >= 0; i-- {
+		if !candidates[i].After(t) {
+			return candidates[i]
+		}
+	}
+	// unreachable, since the first candidate is at least a week before t
+	return time.Time{}
+}
+
+// String returns the WeekTime with an abbreviated day of the week, eg "Mon 09:00".
+func (w WeekTime) String() string {
+	return w.format(false)
+}
+
+// format returns the short or long form of the WeekTime, eg "Mon 09:00" or "Monday 09:00:00".
+func (w WeekTime) format(long bool) string {
+	return fmt.Sprintf("%s %s", formatWeekday(w.Weekday, long), w.TimeOfDay.format(long))
+}
+
+// sinceWeekStart returns the wall clock duration from midnight on Sunday to the WeekTime.
+func (w WeekTime) sinceWeekStart() time.Duration {
+	return time.Duration(w.Weekday)*dayLength + w.TimeOfDay.Duration()
+}
+
+// candidates returns the occurrences of the WeekTime in the location in the week before, the week of and the week
+// after t, in order.
+func (w WeekTime) candidates(t time.Time, loc *time.Location) [3]time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	date := DateOf(t.In(loc))
+	date = date.AddDays(int(w.Weekday) - int(date.Weekday()))
+	return [3]time.Time{
+		w.TimeOfDay.On(date.AddDays(-DaysInWeek), loc),
+		w.TimeOfDay.On(date, loc),
+		w.TimeOfDay.On(date.AddDays(DaysInWeek), loc),
+	}
+}
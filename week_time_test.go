@@ -0,0 +1,186 @@
+// Copyright 2023 SpotHero
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package periodic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeekTime_Compare(t *testing.T) {
+	tests := []struct {
+		name     string
+		w        WeekTime
+		other    WeekTime
+		expected int
+	}{
+		{
+			name:     "earlier day",
+			w:        NewWeekTime(time.Monday, TimeOfDay(23*time.Hour)),
+			other:    NewWeekTime(time.Tuesday, Midnight),
+			expected: -1,
+		}, {
+			name:     "later time on the same day",
+			w:        NewWeekTime(time.Monday, TimeOfDay(10*time.Hour)),
+			other:    NewWeekTime(time.Monday, TimeOfDay(9*time.Hour)),
+			expected: 1,
+		}, {
+			name:     "end of day is the start of the next day",
+			w:        NewWeekTime(time.Monday, EndOfDay),
+			other:    NewWeekTime(time.Tuesday, Midnight),
+			expected: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.w.Compare(test.other))
+			assert.Equal(t, -test.expected, test.other.Compare(test.w))
+			assert.Equal(t, test.expected < 0, test.w.Before(test.other))
+			assert.Equal(t, test.expected > 0, test.w.After(test.other))
+		})
+	}
+}
+
+func TestWeekTime_Add(t *testing.T) {
+	tests := []struct {
+		name     string
+		w        WeekTime
+		d        time.Duration
+		expected WeekTime
+	}{
+		{
+			name:     "within the day",
+			w:        NewWeekTime(time.Monday, TimeOfDay(9*time.Hour)),
+			d:        8 * time.Hour,
+			expected: NewWeekTime(time.Monday, TimeOfDay(17*time.Hour)),
+		}, {
+			name:     "wraps around the end of the week",
+			w:        NewWeekTime(time.Saturday, TimeOfDay(23*time.Hour)),
+			d:        2 * time.Hour,
+			expected: NewWeekTime(time.Sunday, TimeOfDay(time.Hour)),
+		}, {
+			name:     "negative duration wraps around the start of the week",
+			w:        NewWeekTime(time.Sunday, TimeOfDay(time.Hour)),
+			d:        -2 * time.Hour,
+			expected: NewWeekTime(time.Saturday, TimeOfDay(23*time.Hour)),
+		}, {
+			name:     "whole weeks are ignored",
+			w:        NewWeekTime(time.Wednesday, TimeOfDay(12*time.Hour)),
+			d:        3 * weekLength,
+			expected: NewWeekTime(time.Wednesday, TimeOfDay(12*time.Hour)),
+		}, {
+			name:     "end of day is normalized",
+			w:        NewWeekTime(time.Friday, EndOfDay),
+			expected: NewWeekTime(time.Saturday, Midnight),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.w.Add(test.d))
+		})
+	}
+}
+
+func TestWeekTime_Until(t *testing.T) {
+	friday := NewWeekTime(time.Friday, TimeOfDay(17*time.Hour))
+	monday := NewWeekTime(time.Monday, TimeOfDay(9*time.Hour))
+	assert.Equal(t, 64*time.Hour, friday.Until(monday))
+	assert.Equal(t, 104*time.Hour, monday.Until(friday))
+	assert.Equal(t, time.Duration(0), monday.Until(monday))
+	assert.Equal(t, monday, friday.Add(friday.Until(monday)))
+}
+
+func TestWeekTime_NextPrev(t *testing.T) {
+	chiTz, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	sunday := NewWeekTime(time.Sunday, TimeOfDay(2*time.Hour+30*time.Minute))
+	mondayEnd := NewWeekTime(time.Monday, EndOfDay)
+	tests := []struct {
+		t            time.Time
+		expectedNext time.Time
+		expectedPrev time.Time
+		loc          *time.Location
+		name         string
+		w            WeekTime
+	}{
+		{
+			name:         "later in the week",
+			w:            NewWeekTime(time.Friday, TimeOfDay(17*time.Hour)),
+			t:            time.Date(2019, 3, 6, 12, 0, 0, 0, chiTz),
+			loc:          chiTz,
+			expectedNext: time.Date(2019, 3, 8, 17, 0, 0, 0, chiTz),
+			expectedPrev: time.Date(2019, 3, 1, 17, 0, 0, 0, chiTz),
+		}, {
+			name:         "the same time is both next and previous",
+			w:            NewWeekTime(time.Wednesday, TimeOfDay(12*time.Hour)),
+			t:            time.Date(2019, 3, 6, 12, 0, 0, 0, chiTz),
+			loc:          chiTz,
+			expectedNext: time.Date(2019, 3, 6, 12, 0, 0, 0, chiTz),
+			expectedPrev: time.Date(2019, 3, 6, 12, 0, 0, 0, chiTz),
+		}, {
+			name:         "time is converted to the location",
+			w:            NewWeekTime(time.Wednesday, TimeOfDay(20*time.Hour)),
+			t:            time.Date(2019, 3, 7, 3, 0, 0, 0, time.UTC),
+			loc:          chiTz,
+			expectedNext: time.Date(2019, 3, 13, 20, 0, 0, 0, chiTz),
+			expectedPrev: time.Date(2019, 3, 6, 20, 0, 0, 0, chiTz),
+		}, {
+			name:         "time skipped by daylight saving time resolves to the end of the transition",
+			w:            sunday,
+			t:            time.Date(2019, 3, 9, 12, 0, 0, 0, chiTz),
+			loc:          chiTz,
+			expectedNext: time.Date(2019, 3, 10, 3, 0, 0, 0, chiTz),
+			expectedPrev: time.Date(2019, 3, 3, 2, 30, 0, 0, chiTz),
+		}, {
+			name:         "end of day is the start of the next day",
+			w:            mondayEnd,
+			t:            time.Date(2019, 3, 5, 0, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2019, 3, 5, 0, 0, 0, 0, time.UTC),
+			expectedPrev: time.Date(2019, 3, 5, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next := test.w.Next(test.t, test.loc)
+			assert.True(t, test.expectedNext.Equal(next), "expected next %v, got %v", test.expectedNext, next)
+			prev := test.w.Prev(test.t, test.loc)
+			assert.True(t, test.expectedPrev.Equal(prev), "expected prev %v, got %v", test.expectedPrev, prev)
+		})
+	}
+}
+
+func TestWeekTime_String(t *testing.T) {
+	w := NewWeekTime(time.Monday, TimeOfDay(9*time.Hour))
+	assert.Equal(t, "Mon 09:00", w.String())
+	assert.Equal(t, "Monday 09:00:00", w.format(true))
+	assert.Equal(t, w, WeekTimeOf(time.Date(2019, 3, 4, 9, 0, 0, 0, time.UTC)))
+	assert.False(t, NewWeekTime(7, Midnight).Valid())
+	assert.False(t, NewWeekTime(time.Monday, TimeOfDay(-time.Hour)).Valid())
+}
+
+func TestContinuousPeriod_WeekTime(t *testing.T) {
+	start := NewWeekTime(time.Friday, TimeOfDay(17*time.Hour))
+	end := NewWeekTime(time.Monday, TimeOfDay(9*time.Hour))
+	cp, err := NewContinuousPeriodFromWeekTime(start, end, nil)
+	require.NoError(t, err)
+	assert.Equal(t, NewContinuousPeriod(17*time.Hour, 9*time.Hour, time.Friday, time.Monday, nil), cp)
+	assert.Equal(t, start, cp.StartWeekTime())
+	assert.Equal(t, end, cp.EndWeekTime())
+	_, err = NewContinuousPeriodFromWeekTime(start, NewWeekTime(8, Midnight), nil)
+	assert.IsType(t, ContinuousPeriodConstructionError(""), err)
+}